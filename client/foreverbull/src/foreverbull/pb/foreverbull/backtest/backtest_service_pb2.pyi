@@ -1,6 +1,9 @@
 from foreverbull.pb.foreverbull.backtest import backtest_pb2 as _backtest_pb2
 from foreverbull.pb.foreverbull.backtest import session_pb2 as _session_pb2
 from foreverbull.pb.foreverbull.backtest import execution_pb2 as _execution_pb2
+from foreverbull.pb.foreverbull import common_pb2 as _common_pb2
+from google.protobuf import struct_pb2 as _struct_pb2
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from foreverbull.pb.buf.validate import validate_pb2 as _validate_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf import descriptor as _descriptor
@@ -96,3 +99,53 @@ class GetExecutionResponse(_message.Message):
     execution: _execution_pb2.Execution
     periods: _containers.RepeatedCompositeFieldContainer[_execution_pb2.Period]
     def __init__(self, execution: _Optional[_Union[_execution_pb2.Execution, _Mapping]] = ..., periods: _Optional[_Iterable[_Union[_execution_pb2.Period, _Mapping]]] = ...) -> None: ...
+
+class GetExecutionNamespaceRequest(_message.Message):
+    __slots__ = ("execution_id", "date")
+    EXECUTION_ID_FIELD_NUMBER: _ClassVar[int]
+    DATE_FIELD_NUMBER: _ClassVar[int]
+    execution_id: str
+    date: _common_pb2.Date
+    def __init__(self, execution_id: _Optional[str] = ..., date: _Optional[_Union[_common_pb2.Date, _Mapping]] = ...) -> None: ...
+
+class GetExecutionNamespaceResponse(_message.Message):
+    __slots__ = ("date", "namespaces")
+    class NamespacesEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: _struct_pb2.Struct
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ...) -> None: ...
+    DATE_FIELD_NUMBER: _ClassVar[int]
+    NAMESPACES_FIELD_NUMBER: _ClassVar[int]
+    date: _common_pb2.Date
+    namespaces: _containers.MessageMap[str, _struct_pb2.Struct]
+    def __init__(self, date: _Optional[_Union[_common_pb2.Date, _Mapping]] = ..., namespaces: _Optional[_Mapping[str, _struct_pb2.Struct]] = ...) -> None: ...
+
+class ListPortLeasesRequest(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class ListPortLeasesResponse(_message.Message):
+    __slots__ = ("range_start", "range_end", "leases")
+    class Lease(_message.Message):
+        __slots__ = ("port", "owner_kind", "owner_id", "acquired", "expires")
+        PORT_FIELD_NUMBER: _ClassVar[int]
+        OWNER_KIND_FIELD_NUMBER: _ClassVar[int]
+        OWNER_ID_FIELD_NUMBER: _ClassVar[int]
+        ACQUIRED_FIELD_NUMBER: _ClassVar[int]
+        EXPIRES_FIELD_NUMBER: _ClassVar[int]
+        port: int
+        owner_kind: str
+        owner_id: str
+        acquired: _timestamp_pb2.Timestamp
+        expires: _timestamp_pb2.Timestamp
+        def __init__(self, port: _Optional[int] = ..., owner_kind: _Optional[str] = ..., owner_id: _Optional[str] = ..., acquired: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., expires: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+    RANGE_START_FIELD_NUMBER: _ClassVar[int]
+    RANGE_END_FIELD_NUMBER: _ClassVar[int]
+    LEASES_FIELD_NUMBER: _ClassVar[int]
+    range_start: int
+    range_end: int
+    leases: _containers.RepeatedCompositeFieldContainer[ListPortLeasesResponse.Lease]
+    def __init__(self, range_start: _Optional[int] = ..., range_end: _Optional[int] = ..., leases: _Optional[_Iterable[_Union[ListPortLeasesResponse.Lease, _Mapping]]] = ...) -> None: ...
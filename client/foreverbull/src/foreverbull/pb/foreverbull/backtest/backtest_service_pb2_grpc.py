@@ -69,6 +69,16 @@ class BacktestServicerStub(object):
                 request_serializer=foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionRequest.SerializeToString,
                 response_deserializer=foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionResponse.FromString,
                 _registered_method=True)
+        self.GetExecutionNamespace = channel.unary_unary(
+                '/foreverbull.backtest.BacktestServicer/GetExecutionNamespace',
+                request_serializer=foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionNamespaceRequest.SerializeToString,
+                response_deserializer=foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionNamespaceResponse.FromString,
+                _registered_method=True)
+        self.ListPortLeases = channel.unary_unary(
+                '/foreverbull.backtest.BacktestServicer/ListPortLeases',
+                request_serializer=foreverbull_dot_backtest_dot_backtest__service__pb2.ListPortLeasesRequest.SerializeToString,
+                response_deserializer=foreverbull_dot_backtest_dot_backtest__service__pb2.ListPortLeasesResponse.FromString,
+                _registered_method=True)
 
 
 class BacktestServicerServicer(object):
@@ -116,6 +126,18 @@ class BacktestServicerServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetExecutionNamespace(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListPortLeases(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_BacktestServicerServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -154,6 +176,16 @@ def add_BacktestServicerServicer_to_server(servicer, server):
                     request_deserializer=foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionRequest.FromString,
                     response_serializer=foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionResponse.SerializeToString,
             ),
+            'GetExecutionNamespace': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetExecutionNamespace,
+                    request_deserializer=foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionNamespaceRequest.FromString,
+                    response_serializer=foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionNamespaceResponse.SerializeToString,
+            ),
+            'ListPortLeases': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListPortLeases,
+                    request_deserializer=foreverbull_dot_backtest_dot_backtest__service__pb2.ListPortLeasesRequest.FromString,
+                    response_serializer=foreverbull_dot_backtest_dot_backtest__service__pb2.ListPortLeasesResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'foreverbull.backtest.BacktestServicer', rpc_method_handlers)
@@ -353,3 +385,57 @@ class BacktestServicer(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def GetExecutionNamespace(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/foreverbull.backtest.BacktestServicer/GetExecutionNamespace',
+            foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionNamespaceRequest.SerializeToString,
+            foreverbull_dot_backtest_dot_backtest__service__pb2.GetExecutionNamespaceResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ListPortLeases(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/foreverbull.backtest.BacktestServicer/ListPortLeases',
+            foreverbull_dot_backtest_dot_backtest__service__pb2.ListPortLeasesRequest.SerializeToString,
+            foreverbull_dot_backtest_dot_backtest__service__pb2.ListPortLeasesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -1,6 +1,7 @@
 from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from foreverbull.pb.foreverbull.finance import finance_pb2 as _finance_pb2
 from foreverbull.pb.foreverbull import common_pb2 as _common_pb2
+from foreverbull.pb.foreverbull.service import worker_pb2 as _worker_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
@@ -10,7 +11,7 @@ from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Map
 DESCRIPTOR: _descriptor.FileDescriptor
 
 class Execution(_message.Message):
-    __slots__ = ("id", "backtest", "session", "start_date", "end_date", "benchmark", "symbols", "statuses", "result")
+    __slots__ = ("id", "backtest", "session", "start_date", "end_date", "benchmark", "symbols", "statuses", "result", "functions")
     class Status(_message.Message):
         __slots__ = ("status", "error", "occurred_at")
         class Status(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
@@ -39,6 +40,7 @@ class Execution(_message.Message):
     SYMBOLS_FIELD_NUMBER: _ClassVar[int]
     STATUSES_FIELD_NUMBER: _ClassVar[int]
     RESULT_FIELD_NUMBER: _ClassVar[int]
+    FUNCTIONS_FIELD_NUMBER: _ClassVar[int]
     id: str
     backtest: str
     session: str
@@ -48,7 +50,8 @@ class Execution(_message.Message):
     symbols: _containers.RepeatedScalarFieldContainer[str]
     statuses: _containers.RepeatedCompositeFieldContainer[Execution.Status]
     result: Period
-    def __init__(self, id: _Optional[str] = ..., backtest: _Optional[str] = ..., session: _Optional[str] = ..., start_date: _Optional[_Union[_common_pb2.Date, _Mapping]] = ..., end_date: _Optional[_Union[_common_pb2.Date, _Mapping]] = ..., benchmark: _Optional[str] = ..., symbols: _Optional[_Iterable[str]] = ..., statuses: _Optional[_Iterable[_Union[Execution.Status, _Mapping]]] = ..., result: _Optional[_Union[Period, _Mapping]] = ...) -> None: ...
+    functions: _containers.RepeatedCompositeFieldContainer[_worker_pb2.ExecutionConfiguration.Function]
+    def __init__(self, id: _Optional[str] = ..., backtest: _Optional[str] = ..., session: _Optional[str] = ..., start_date: _Optional[_Union[_common_pb2.Date, _Mapping]] = ..., end_date: _Optional[_Union[_common_pb2.Date, _Mapping]] = ..., benchmark: _Optional[str] = ..., symbols: _Optional[_Iterable[str]] = ..., statuses: _Optional[_Iterable[_Union[Execution.Status, _Mapping]]] = ..., result: _Optional[_Union[Period, _Mapping]] = ..., functions: _Optional[_Iterable[_Union[_worker_pb2.ExecutionConfiguration.Function, _Mapping]]] = ...) -> None: ...
 
 class Period(_message.Message):
     __slots__ = ("date", "PNL", "returns", "portfolio_value", "longs_count", "shorts_count", "long_value", "short_value", "starting_exposure", "ending_exposure", "long_exposure", "short_exposure", "capital_used", "gross_leverage", "net_leverage", "starting_value", "ending_value", "starting_cash", "ending_cash", "max_drawdown", "max_leverage", "excess_return", "treasury_period_return", "algorithm_period_return", "algo_volatility", "sharpe", "sortino", "benchmark_period_return", "benchmark_volatility", "alpha", "beta", "positions")
@@ -10,12 +10,14 @@ from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Opti
 DESCRIPTOR: _descriptor.FileDescriptor
 
 class CreateExecutionRequest(_message.Message):
-    __slots__ = ("backtest", "algorithm")
+    __slots__ = ("backtest", "algorithm", "transport")
     BACKTEST_FIELD_NUMBER: _ClassVar[int]
     ALGORITHM_FIELD_NUMBER: _ClassVar[int]
+    TRANSPORT_FIELD_NUMBER: _ClassVar[int]
     backtest: _backtest_pb2.Backtest
     algorithm: _worker_pb2.Algorithm
-    def __init__(self, backtest: _Optional[_Union[_backtest_pb2.Backtest, _Mapping]] = ..., algorithm: _Optional[_Union[_worker_pb2.Algorithm, _Mapping]] = ...) -> None: ...
+    transport: _worker_pb2.WorkerTransport
+    def __init__(self, backtest: _Optional[_Union[_backtest_pb2.Backtest, _Mapping]] = ..., algorithm: _Optional[_Union[_worker_pb2.Algorithm, _Mapping]] = ..., transport: _Optional[_Union[_worker_pb2.WorkerTransport, str]] = ...) -> None: ...
 
 class CreateExecutionResponse(_message.Message):
     __slots__ = ("execution", "configuration")
@@ -22,9 +22,11 @@ _runtime_version.ValidateProtobufRuntimeVersion(
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+from foreverbull.pb.foreverbull.finance import finance_pb2 as foreverbull_dot_finance_dot_finance__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n foreverbull/service/worker.proto\x12\x13\x66oreverbull.service\"\x8c\x03\n\tAlgorithm\x12\x11\n\tfile_path\x18\x01 \x01(\t\x12:\n\tfunctions\x18\x02 \x03(\x0b\x32\'.foreverbull.service.Algorithm.Function\x12\x12\n\nnamespaces\x18\x03 \x03(\t\x1a}\n\x11\x46unctionParameter\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x19\n\x0c\x64\x65\x66\x61ultValue\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x12\n\x05value\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x11\n\tvalueType\x18\x04 \x01(\tB\x0f\n\r_defaultValueB\x08\n\x06_value\x1a\x9c\x01\n\x08\x46unction\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x44\n\nparameters\x18\x02 \x03(\x0b\x32\x30.foreverbull.service.Algorithm.FunctionParameter\x12\x19\n\x11parallelExecution\x18\x03 \x01(\x08\x12\x10\n\x08runFirst\x18\x04 \x01(\x08\x12\x0f\n\x07runLast\x18\x05 \x01(\x08\"\xbf\x02\n\x16\x45xecutionConfiguration\x12\x12\n\nbrokerPort\x18\x01 \x01(\x05\x12\x15\n\rnamespacePort\x18\x02 \x01(\x05\x12\x13\n\x0b\x64\x61tabaseURL\x18\x03 \x01(\t\x12G\n\tfunctions\x18\x04 \x03(\x0b\x32\x34.foreverbull.service.ExecutionConfiguration.Function\x1a/\n\x11\x46unctionParameter\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x1ak\n\x08\x46unction\x12\x0c\n\x04name\x18\x01 \x01(\t\x12Q\n\nparameters\x18\x02 \x03(\x0b\x32=.foreverbull.service.ExecutionConfiguration.FunctionParameterB2Z0github.com/lhjnilsson/foreverbull/pkg/pb/serviceb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n foreverbull/service/worker.proto\x12\x13\x66oreverbull.service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!foreverbull/finance/finance.proto\"\xcb\x04\n\tAlgorithm\x12\x11\n\tfile_path\x18\x01 \x01(\t\x12:\n\tfunctions\x18\x02 \x03(\x0b\x32\'.foreverbull.service.Algorithm.Function\x12\x12\n\nnamespaces\x18\x03 \x03(\t\x12M\n\x10namespace_scopes\x18\x04 \x03(\x0b\x32\x33.foreverbull.service.Algorithm.NamespaceScopesEntry\x1a}\n\x11\x46unctionParameter\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x19\n\x0c\x64\x65\x66\x61ultValue\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x12\n\x05value\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x11\n\tvalueType\x18\x04 \x01(\tB\x0f\n\r_defaultValueB\x08\n\x06_value\x1a\xaf\x01\n\x08\x46unction\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x44\n\nparameters\x18\x02 \x03(\x0b\x32\x30.foreverbull.service.Algorithm.FunctionParameter\x12\x19\n\x11parallelExecution\x18\x03 \x01(\x08\x12\x10\n\x08runFirst\x18\x04 \x01(\x08\x12\x0f\n\x07runLast\x18\x05 \x01(\x08\x12\x11\n\tdependsOn\x18\x06 \x03(\t\x1a[\n\x14NamespaceScopesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0e\x32#.foreverbull.service.NamespaceScope:\x02\x38\x01\"\xf4\x04\n\x16\x45xecutionConfiguration\x12\x12\n\nbrokerPort\x18\x01 \x01(\x05\x12\x15\n\rnamespacePort\x18\x02 \x01(\x05\x12G\n\tfunctions\x18\x04 \x03(\x0b\x32\x34.foreverbull.service.ExecutionConfiguration.Function\x12\x15\n\rbroadcastPort\x18\x05 \x01(\x05\x12\x37\n\ttransport\x18\x06 \x01(\x0e\x32$.foreverbull.service.WorkerTransport\x12\x0c\n\x04pool\x18\x07 \x01(\t\x12Q\n\x0b\x63redentials\x18\x08 \x01(\x0b\x32\x37.foreverbull.service.ExecutionConfiguration.CredentialsH\x00\x88\x01\x01\x12\x11\n\tpoolToken\x18\t \x01(\t\x1a\x61\n\x0b\x43redentials\x12\x15\n\rcaCertificate\x18\x01 \x01(\x0c\x12\x13\n\x0b\x63\x65rtificate\x18\x02 \x01(\x0c\x12\x12\n\nprivateKey\x18\x03 \x01(\x0c\x12\x12\n\nserverName\x18\x04 \x01(\t\x1a/\n\x11\x46unctionParameter\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t\x1ak\n\x08\x46unction\x12\x0c\n\x04name\x18\x01 \x01(\t\x12Q\n\nparameters\x18\x02 \x03(\x0b\x32=.foreverbull.service.ExecutionConfiguration.FunctionParameterB\x0e\n\x0c_credentialsJ\x04\x08\x03\x10\x04R\x0b\x64\x61tabaseURL\"\xd9\x02\n\tBroadcast\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x33\n\tportfolio\x18\x02 \x01(\x0b\x32\x1e.foreverbull.finance.PortfolioH\x00\x12\x37\n\x06orders\x18\x03 \x01(\x0b\x32%.foreverbull.service.Broadcast.OrdersH\x00\x12\x35\n\x05\x65vent\x18\x04 \x01(\x0b\x32$.foreverbull.service.Broadcast.EventH\x00\x1a\x34\n\x06Orders\x12*\n\x06orders\x18\x01 \x03(\x0b\x32\x1a.foreverbull.finance.Order\x1a\x37\n\x05\x45vent\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x07message\x18\x02 \x01(\tH\x00\x88\x01\x01\x42\n\n\x08_messageB\t\n\x07payload*8\n\x0eNamespaceScope\x12\n\n\x06PERIOD\x10\x00\x12\r\n\tEXECUTION\x10\x01\x12\x0b\n\x07SESSION\x10\x02*\'\n\x0fWorkerTransport\x12\n\n\x06MANGOS\x10\x00\x12\x08\n\x04GRPC\x10\x01\x42\x32Z0github.com/lhjnilsson/foreverbull/pkg/pb/serviceb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -32,16 +34,32 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'foreverbull.service.worker_
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z0github.com/lhjnilsson/foreverbull/pkg/pb/service'
-  _globals['_ALGORITHM']._serialized_start=58
-  _globals['_ALGORITHM']._serialized_end=454
-  _globals['_ALGORITHM_FUNCTIONPARAMETER']._serialized_start=170
-  _globals['_ALGORITHM_FUNCTIONPARAMETER']._serialized_end=295
-  _globals['_ALGORITHM_FUNCTION']._serialized_start=298
-  _globals['_ALGORITHM_FUNCTION']._serialized_end=454
-  _globals['_EXECUTIONCONFIGURATION']._serialized_start=457
-  _globals['_EXECUTIONCONFIGURATION']._serialized_end=776
-  _globals['_EXECUTIONCONFIGURATION_FUNCTIONPARAMETER']._serialized_start=620
-  _globals['_EXECUTIONCONFIGURATION_FUNCTIONPARAMETER']._serialized_end=667
-  _globals['_EXECUTIONCONFIGURATION_FUNCTION']._serialized_start=669
-  _globals['_EXECUTIONCONFIGURATION_FUNCTION']._serialized_end=776
+  _globals['_ALGORITHM_NAMESPACESCOPESENTRY']._loaded_options = None
+  _globals['_ALGORITHM_NAMESPACESCOPESENTRY']._serialized_options = b'8\001'
+  _globals['_NAMESPACESCOPE']._serialized_start=1694
+  _globals['_NAMESPACESCOPE']._serialized_end=1750
+  _globals['_WORKERTRANSPORT']._serialized_start=1752
+  _globals['_WORKERTRANSPORT']._serialized_end=1791
+  _globals['_ALGORITHM']._serialized_start=126
+  _globals['_ALGORITHM']._serialized_end=713
+  _globals['_ALGORITHM_FUNCTIONPARAMETER']._serialized_start=317
+  _globals['_ALGORITHM_FUNCTIONPARAMETER']._serialized_end=442
+  _globals['_ALGORITHM_FUNCTION']._serialized_start=445
+  _globals['_ALGORITHM_FUNCTION']._serialized_end=620
+  _globals['_ALGORITHM_NAMESPACESCOPESENTRY']._serialized_start=622
+  _globals['_ALGORITHM_NAMESPACESCOPESENTRY']._serialized_end=713
+  _globals['_EXECUTIONCONFIGURATION']._serialized_start=716
+  _globals['_EXECUTIONCONFIGURATION']._serialized_end=1344
+  _globals['_EXECUTIONCONFIGURATION_CREDENTIALS']._serialized_start=1054
+  _globals['_EXECUTIONCONFIGURATION_CREDENTIALS']._serialized_end=1151
+  _globals['_EXECUTIONCONFIGURATION_FUNCTIONPARAMETER']._serialized_start=1153
+  _globals['_EXECUTIONCONFIGURATION_FUNCTIONPARAMETER']._serialized_end=1200
+  _globals['_EXECUTIONCONFIGURATION_FUNCTION']._serialized_start=1202
+  _globals['_EXECUTIONCONFIGURATION_FUNCTION']._serialized_end=1309
+  _globals['_BROADCAST']._serialized_start=1347
+  _globals['_BROADCAST']._serialized_end=1692
+  _globals['_BROADCAST_ORDERS']._serialized_start=1572
+  _globals['_BROADCAST_ORDERS']._serialized_end=1624
+  _globals['_BROADCAST_EVENT']._serialized_start=1626
+  _globals['_BROADCAST_EVENT']._serialized_end=1681
 # @@protoc_insertion_point(module_scope)
@@ -1,12 +1,31 @@
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
+from foreverbull.pb.foreverbull.finance import finance_pb2 as _finance_pb2
 from google.protobuf.internal import containers as _containers
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
+class NamespaceScope(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    PERIOD: _ClassVar[NamespaceScope]
+    EXECUTION: _ClassVar[NamespaceScope]
+    SESSION: _ClassVar[NamespaceScope]
+
+class WorkerTransport(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    MANGOS: _ClassVar[WorkerTransport]
+    GRPC: _ClassVar[WorkerTransport]
+PERIOD: NamespaceScope
+EXECUTION: NamespaceScope
+SESSION: NamespaceScope
+MANGOS: WorkerTransport
+GRPC: WorkerTransport
+
 class Algorithm(_message.Message):
-    __slots__ = ("file_path", "functions", "namespaces")
+    __slots__ = ("file_path", "functions", "namespaces", "namespace_scopes")
     class FunctionParameter(_message.Message):
         __slots__ = ("key", "defaultValue", "value", "valueType")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -19,28 +38,50 @@ class Algorithm(_message.Message):
         valueType: str
         def __init__(self, key: _Optional[str] = ..., defaultValue: _Optional[str] = ..., value: _Optional[str] = ..., valueType: _Optional[str] = ...) -> None: ...
     class Function(_message.Message):
-        __slots__ = ("name", "parameters", "parallelExecution", "runFirst", "runLast")
+        __slots__ = ("name", "parameters", "parallelExecution", "runFirst", "runLast", "dependsOn")
         NAME_FIELD_NUMBER: _ClassVar[int]
         PARAMETERS_FIELD_NUMBER: _ClassVar[int]
         PARALLELEXECUTION_FIELD_NUMBER: _ClassVar[int]
         RUNFIRST_FIELD_NUMBER: _ClassVar[int]
         RUNLAST_FIELD_NUMBER: _ClassVar[int]
+        DEPENDSON_FIELD_NUMBER: _ClassVar[int]
         name: str
         parameters: _containers.RepeatedCompositeFieldContainer[Algorithm.FunctionParameter]
         parallelExecution: bool
         runFirst: bool
         runLast: bool
-        def __init__(self, name: _Optional[str] = ..., parameters: _Optional[_Iterable[_Union[Algorithm.FunctionParameter, _Mapping]]] = ..., parallelExecution: bool = ..., runFirst: bool = ..., runLast: bool = ...) -> None: ...
+        dependsOn: _containers.RepeatedScalarFieldContainer[str]
+        def __init__(self, name: _Optional[str] = ..., parameters: _Optional[_Iterable[_Union[Algorithm.FunctionParameter, _Mapping]]] = ..., parallelExecution: bool = ..., runFirst: bool = ..., runLast: bool = ..., dependsOn: _Optional[_Iterable[str]] = ...) -> None: ...
+    class NamespaceScopesEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: NamespaceScope
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[_Union[NamespaceScope, str]] = ...) -> None: ...
     FILE_PATH_FIELD_NUMBER: _ClassVar[int]
     FUNCTIONS_FIELD_NUMBER: _ClassVar[int]
     NAMESPACES_FIELD_NUMBER: _ClassVar[int]
+    NAMESPACE_SCOPES_FIELD_NUMBER: _ClassVar[int]
     file_path: str
     functions: _containers.RepeatedCompositeFieldContainer[Algorithm.Function]
     namespaces: _containers.RepeatedScalarFieldContainer[str]
-    def __init__(self, file_path: _Optional[str] = ..., functions: _Optional[_Iterable[_Union[Algorithm.Function, _Mapping]]] = ..., namespaces: _Optional[_Iterable[str]] = ...) -> None: ...
+    namespace_scopes: _containers.ScalarMap[str, NamespaceScope]
+    def __init__(self, file_path: _Optional[str] = ..., functions: _Optional[_Iterable[_Union[Algorithm.Function, _Mapping]]] = ..., namespaces: _Optional[_Iterable[str]] = ..., namespace_scopes: _Optional[_Mapping[str, NamespaceScope]] = ...) -> None: ...
 
 class ExecutionConfiguration(_message.Message):
-    __slots__ = ("brokerPort", "namespacePort", "databaseURL", "functions")
+    __slots__ = ("brokerPort", "namespacePort", "functions", "broadcastPort", "transport", "pool", "credentials", "poolToken")
+    class Credentials(_message.Message):
+        __slots__ = ("caCertificate", "certificate", "privateKey", "serverName")
+        CACERTIFICATE_FIELD_NUMBER: _ClassVar[int]
+        CERTIFICATE_FIELD_NUMBER: _ClassVar[int]
+        PRIVATEKEY_FIELD_NUMBER: _ClassVar[int]
+        SERVERNAME_FIELD_NUMBER: _ClassVar[int]
+        caCertificate: bytes
+        certificate: bytes
+        privateKey: bytes
+        serverName: str
+        def __init__(self, caCertificate: _Optional[bytes] = ..., certificate: _Optional[bytes] = ..., privateKey: _Optional[bytes] = ..., serverName: _Optional[str] = ...) -> None: ...
     class FunctionParameter(_message.Message):
         __slots__ = ("key", "value")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -57,10 +98,42 @@ class ExecutionConfiguration(_message.Message):
         def __init__(self, name: _Optional[str] = ..., parameters: _Optional[_Iterable[_Union[ExecutionConfiguration.FunctionParameter, _Mapping]]] = ...) -> None: ...
     BROKERPORT_FIELD_NUMBER: _ClassVar[int]
     NAMESPACEPORT_FIELD_NUMBER: _ClassVar[int]
-    DATABASEURL_FIELD_NUMBER: _ClassVar[int]
     FUNCTIONS_FIELD_NUMBER: _ClassVar[int]
+    BROADCASTPORT_FIELD_NUMBER: _ClassVar[int]
+    TRANSPORT_FIELD_NUMBER: _ClassVar[int]
+    POOL_FIELD_NUMBER: _ClassVar[int]
+    CREDENTIALS_FIELD_NUMBER: _ClassVar[int]
+    POOLTOKEN_FIELD_NUMBER: _ClassVar[int]
     brokerPort: int
     namespacePort: int
-    databaseURL: str
     functions: _containers.RepeatedCompositeFieldContainer[ExecutionConfiguration.Function]
-    def __init__(self, brokerPort: _Optional[int] = ..., namespacePort: _Optional[int] = ..., databaseURL: _Optional[str] = ..., functions: _Optional[_Iterable[_Union[ExecutionConfiguration.Function, _Mapping]]] = ...) -> None: ...
+    broadcastPort: int
+    transport: WorkerTransport
+    pool: str
+    credentials: ExecutionConfiguration.Credentials
+    poolToken: str
+    def __init__(self, brokerPort: _Optional[int] = ..., namespacePort: _Optional[int] = ..., functions: _Optional[_Iterable[_Union[ExecutionConfiguration.Function, _Mapping]]] = ..., broadcastPort: _Optional[int] = ..., transport: _Optional[_Union[WorkerTransport, str]] = ..., pool: _Optional[str] = ..., credentials: _Optional[_Union[ExecutionConfiguration.Credentials, _Mapping]] = ..., poolToken: _Optional[str] = ...) -> None: ...
+
+class Broadcast(_message.Message):
+    __slots__ = ("timestamp", "portfolio", "orders", "event")
+    class Orders(_message.Message):
+        __slots__ = ("orders",)
+        ORDERS_FIELD_NUMBER: _ClassVar[int]
+        orders: _containers.RepeatedCompositeFieldContainer[_finance_pb2.Order]
+        def __init__(self, orders: _Optional[_Iterable[_Union[_finance_pb2.Order, _Mapping]]] = ...) -> None: ...
+    class Event(_message.Message):
+        __slots__ = ("name", "message")
+        NAME_FIELD_NUMBER: _ClassVar[int]
+        MESSAGE_FIELD_NUMBER: _ClassVar[int]
+        name: str
+        message: str
+        def __init__(self, name: _Optional[str] = ..., message: _Optional[str] = ...) -> None: ...
+    TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    PORTFOLIO_FIELD_NUMBER: _ClassVar[int]
+    ORDERS_FIELD_NUMBER: _ClassVar[int]
+    EVENT_FIELD_NUMBER: _ClassVar[int]
+    timestamp: _timestamp_pb2.Timestamp
+    portfolio: _finance_pb2.Portfolio
+    orders: Broadcast.Orders
+    event: Broadcast.Event
+    def __init__(self, timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., portfolio: _Optional[_Union[_finance_pb2.Portfolio, _Mapping]] = ..., orders: _Optional[_Union[Broadcast.Orders, _Mapping]] = ..., event: _Optional[_Union[Broadcast.Event, _Mapping]] = ...) -> None: ...
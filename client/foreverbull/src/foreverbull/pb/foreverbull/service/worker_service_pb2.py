@@ -28,7 +28,7 @@ from foreverbull.pb.foreverbull.service import worker_pb2 as foreverbull_dot_ser
 from foreverbull.pb.buf.validate import validate_pb2 as buf_dot_validate_dot_validate__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n(foreverbull/service/worker_service.proto\x12\x13\x66oreverbull.service\x1a\x1cgoogle/protobuf/struct.proto\x1a!foreverbull/finance/finance.proto\x1a foreverbull/service/worker.proto\x1a\x1b\x62uf/validate/validate.proto\"\x17\n\x15GetServiceInfoRequest\"K\n\x16GetServiceInfoResponse\x12\x31\n\talgorithm\x18\x01 \x01(\x0b\x32\x1e.foreverbull.service.Algorithm\"\x82\x01\n\x19\x43onfigureExecutionRequest\x12\x65\n\rconfiguration\x18\x01 \x01(\x0b\x32+.foreverbull.service.ExecutionConfigurationB!\xbaH\x1e\xba\x01\x18\n\x08required\x1a\x0cthis != null\xc8\x01\x01\"\x1c\n\x1a\x43onfigureExecutionResponse\"\x15\n\x13RunExecutionRequest\"\x16\n\x14RunExecutionResponse\"a\n\rWorkerRequest\x12\x0c\n\x04task\x18\x01 \x01(\t\x12\x0f\n\x07symbols\x18\x02 \x03(\t\x12\x31\n\tportfolio\x18\x03 \x01(\x0b\x32\x1e.foreverbull.finance.Portfolio\"h\n\x0eWorkerResponse\x12\x0c\n\x04task\x18\x01 \x01(\t\x12*\n\x06orders\x18\x02 \x03(\x0b\x32\x1a.foreverbull.finance.Order\x12\x12\n\x05\x65rror\x18\x03 \x01(\tH\x00\x88\x01\x01\x42\x08\n\x06_error\"\x8f\x01\n\x10NamespaceRequest\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x37\n\x04type\x18\x02 \x01(\x0e\x32).foreverbull.service.NamespaceRequestType\x12+\n\x05value\x18\x03 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x88\x01\x01\x42\x08\n\x06_value\"h\n\x11NamespaceResponse\x12+\n\x05value\x18\x01 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x88\x01\x01\x12\x12\n\x05\x65rror\x18\x02 \x01(\tH\x01\x88\x01\x01\x42\x08\n\x06_valueB\x08\n\x06_error*(\n\x14NamespaceRequestType\x12\x07\n\x03GET\x10\x00\x12\x07\n\x03SET\x10\x01\x32\xd5\x02\n\x06Worker\x12k\n\x0eGetServiceInfo\x12*.foreverbull.service.GetServiceInfoRequest\x1a+.foreverbull.service.GetServiceInfoResponse\"\x00\x12w\n\x12\x43onfigureExecution\x12..foreverbull.service.ConfigureExecutionRequest\x1a/.foreverbull.service.ConfigureExecutionResponse\"\x00\x12\x65\n\x0cRunExecution\x12(.foreverbull.service.RunExecutionRequest\x1a).foreverbull.service.RunExecutionResponse\"\x00\x42\x32Z0github.com/lhjnilsson/foreverbull/pkg/pb/serviceb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n(foreverbull/service/worker_service.proto\x12\x13\x66oreverbull.service\x1a\x1cgoogle/protobuf/struct.proto\x1a!foreverbull/finance/finance.proto\x1a foreverbull/service/worker.proto\x1a\x1b\x62uf/validate/validate.proto\"\x17\n\x15GetServiceInfoRequest\"K\n\x16GetServiceInfoResponse\x12\x31\n\talgorithm\x18\x01 \x01(\x0b\x32\x1e.foreverbull.service.Algorithm\"\x82\x01\n\x19\x43onfigureExecutionRequest\x12\x65\n\rconfiguration\x18\x01 \x01(\x0b\x32+.foreverbull.service.ExecutionConfigurationB!\xbaH\x1e\xba\x01\x18\n\x08required\x1a\x0cthis != null\xc8\x01\x01\"\x1c\n\x1a\x43onfigureExecutionResponse\"\x15\n\x13RunExecutionRequest\"\x16\n\x14RunExecutionResponse\"a\n\rWorkerRequest\x12\x0c\n\x04task\x18\x01 \x01(\t\x12\x0f\n\x07symbols\x18\x02 \x03(\t\x12\x31\n\tportfolio\x18\x03 \x01(\x0b\x32\x1e.foreverbull.finance.Portfolio\"h\n\x0eWorkerResponse\x12\x0c\n\x04task\x18\x01 \x01(\t\x12*\n\x06orders\x18\x02 \x03(\x0b\x32\x1a.foreverbull.finance.Order\x12\x12\n\x05\x65rror\x18\x03 \x01(\tH\x00\x88\x01\x01\x42\x08\n\x06_error\"\xea\x01\n\x10NamespaceRequest\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x37\n\x04type\x18\x02 \x01(\x0e\x32).foreverbull.service.NamespaceRequestType\x12+\n\x05value\x18\x03 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x88\x01\x01\x12\x0e\n\x06\x66ields\x18\x04 \x03(\t\x12.\n\x08\x65xpected\x18\x05 \x01(\x0b\x32\x17.google.protobuf.StructH\x01\x88\x01\x01\x12\x0c\n\x04keys\x18\x06 \x03(\tB\x08\n\x06_valueB\x0b\n\t_expected\"\x85\x02\n\x11NamespaceResponse\x12+\n\x05value\x18\x01 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x88\x01\x01\x12\x12\n\x05\x65rror\x18\x02 \x01(\tH\x01\x88\x01\x01\x12\x0f\n\x07swapped\x18\x03 \x01(\x08\x12\x42\n\x06values\x18\x04 \x03(\x0b\x32\x32.foreverbull.service.NamespaceResponse.ValuesEntry\x1a\x46\n\x0bValuesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct:\x02\x38\x01\x42\x08\n\x06_valueB\x08\n\x06_error*r\n\x14NamespaceRequestType\x12\x07\n\x03GET\x10\x00\x12\x07\n\x03SET\x10\x01\x12\r\n\tINCREMENT\x10\x02\x12\n\n\x06\x41PPEND\x10\x03\x12\n\n\x06\x44\x45LETE\x10\x04\x12\x13\n\x0f\x43OMPARE_AND_SET\x10\x05\x12\x0c\n\x08GET_MANY\x10\x06\x32\xd5\x02\n\x06Worker\x12k\n\x0eGetServiceInfo\x12*.foreverbull.service.GetServiceInfoRequest\x1a+.foreverbull.service.GetServiceInfoResponse\"\x00\x12w\n\x12\x43onfigureExecution\x12..foreverbull.service.ConfigureExecutionRequest\x1a/.foreverbull.service.ConfigureExecutionResponse\"\x00\x12\x65\n\x0cRunExecution\x12(.foreverbull.service.RunExecutionRequest\x1a).foreverbull.service.RunExecutionResponse\"\x00\x32\xc6\x01\n\x0cWorkerBroker\x12X\n\x07\x43onnect\x12#.foreverbull.service.WorkerResponse\x1a\".foreverbull.service.WorkerRequest\"\x00(\x01\x30\x01\x12\\\n\tNamespace\x12%.foreverbull.service.NamespaceRequest\x1a&.foreverbull.service.NamespaceResponse\"\x00\x42\x32Z0github.com/lhjnilsson/foreverbull/pkg/pb/serviceb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -38,8 +38,10 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._serialized_options = b'Z0github.com/lhjnilsson/foreverbull/pkg/pb/service'
   _globals['_CONFIGUREEXECUTIONREQUEST'].fields_by_name['configuration']._loaded_options = None
   _globals['_CONFIGUREEXECUTIONREQUEST'].fields_by_name['configuration']._serialized_options = b'\272H\036\272\001\030\n\010required\032\014this != null\310\001\001'
-  _globals['_NAMESPACEREQUESTTYPE']._serialized_start=962
-  _globals['_NAMESPACEREQUESTTYPE']._serialized_end=1002
+  _globals['_NAMESPACERESPONSE_VALUESENTRY']._loaded_options = None
+  _globals['_NAMESPACERESPONSE_VALUESENTRY']._serialized_options = b'8\001'
+  _globals['_NAMESPACEREQUESTTYPE']._serialized_start=1211
+  _globals['_NAMESPACEREQUESTTYPE']._serialized_end=1325
   _globals['_GETSERVICEINFOREQUEST']._serialized_start=193
   _globals['_GETSERVICEINFOREQUEST']._serialized_end=216
   _globals['_GETSERVICEINFORESPONSE']._serialized_start=218
@@ -57,9 +59,13 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_WORKERRESPONSE']._serialized_start=604
   _globals['_WORKERRESPONSE']._serialized_end=708
   _globals['_NAMESPACEREQUEST']._serialized_start=711
-  _globals['_NAMESPACEREQUEST']._serialized_end=854
-  _globals['_NAMESPACERESPONSE']._serialized_start=856
-  _globals['_NAMESPACERESPONSE']._serialized_end=960
-  _globals['_WORKER']._serialized_start=1005
-  _globals['_WORKER']._serialized_end=1346
+  _globals['_NAMESPACEREQUEST']._serialized_end=945
+  _globals['_NAMESPACERESPONSE']._serialized_start=948
+  _globals['_NAMESPACERESPONSE']._serialized_end=1209
+  _globals['_NAMESPACERESPONSE_VALUESENTRY']._serialized_start=1119
+  _globals['_NAMESPACERESPONSE_VALUESENTRY']._serialized_end=1189
+  _globals['_WORKER']._serialized_start=1328
+  _globals['_WORKER']._serialized_end=1669
+  _globals['_WORKERBROKER']._serialized_start=1672
+  _globals['_WORKERBROKER']._serialized_end=1870
 # @@protoc_insertion_point(module_scope)
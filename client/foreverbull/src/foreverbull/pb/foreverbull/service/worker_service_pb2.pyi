@@ -14,8 +14,18 @@ class NamespaceRequestType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = ()
     GET: _ClassVar[NamespaceRequestType]
     SET: _ClassVar[NamespaceRequestType]
+    INCREMENT: _ClassVar[NamespaceRequestType]
+    APPEND: _ClassVar[NamespaceRequestType]
+    DELETE: _ClassVar[NamespaceRequestType]
+    COMPARE_AND_SET: _ClassVar[NamespaceRequestType]
+    GET_MANY: _ClassVar[NamespaceRequestType]
 GET: NamespaceRequestType
 SET: NamespaceRequestType
+INCREMENT: NamespaceRequestType
+APPEND: NamespaceRequestType
+DELETE: NamespaceRequestType
+COMPARE_AND_SET: NamespaceRequestType
+GET_MANY: NamespaceRequestType
 
 class GetServiceInfoRequest(_message.Message):
     __slots__ = ()
@@ -66,19 +76,36 @@ class WorkerResponse(_message.Message):
     def __init__(self, task: _Optional[str] = ..., orders: _Optional[_Iterable[_Union[_finance_pb2.Order, _Mapping]]] = ..., error: _Optional[str] = ...) -> None: ...
 
 class NamespaceRequest(_message.Message):
-    __slots__ = ("key", "type", "value")
+    __slots__ = ("key", "type", "value", "fields", "expected", "keys")
     KEY_FIELD_NUMBER: _ClassVar[int]
     TYPE_FIELD_NUMBER: _ClassVar[int]
     VALUE_FIELD_NUMBER: _ClassVar[int]
+    FIELDS_FIELD_NUMBER: _ClassVar[int]
+    EXPECTED_FIELD_NUMBER: _ClassVar[int]
+    KEYS_FIELD_NUMBER: _ClassVar[int]
     key: str
     type: NamespaceRequestType
     value: _struct_pb2.Struct
-    def __init__(self, key: _Optional[str] = ..., type: _Optional[_Union[NamespaceRequestType, str]] = ..., value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ...) -> None: ...
+    fields: _containers.RepeatedScalarFieldContainer[str]
+    expected: _struct_pb2.Struct
+    keys: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, key: _Optional[str] = ..., type: _Optional[_Union[NamespaceRequestType, str]] = ..., value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., fields: _Optional[_Iterable[str]] = ..., expected: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., keys: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class NamespaceResponse(_message.Message):
-    __slots__ = ("value", "error")
+    __slots__ = ("value", "error", "swapped", "values")
+    class ValuesEntry(_message.Message):
+        __slots__ = ("key", "value")
+        KEY_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        key: str
+        value: _struct_pb2.Struct
+        def __init__(self, key: _Optional[str] = ..., value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ...) -> None: ...
     VALUE_FIELD_NUMBER: _ClassVar[int]
     ERROR_FIELD_NUMBER: _ClassVar[int]
+    SWAPPED_FIELD_NUMBER: _ClassVar[int]
+    VALUES_FIELD_NUMBER: _ClassVar[int]
     value: _struct_pb2.Struct
     error: str
-    def __init__(self, value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., error: _Optional[str] = ...) -> None: ...
+    swapped: bool
+    values: _containers.MessageMap[str, _struct_pb2.Struct]
+    def __init__(self, value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., error: _Optional[str] = ..., swapped: bool = ..., values: _Optional[_Mapping[str, _struct_pb2.Struct]] = ...) -> None: ...
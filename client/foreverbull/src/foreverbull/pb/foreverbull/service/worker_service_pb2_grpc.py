@@ -181,3 +181,126 @@ class Worker(object):
             timeout,
             metadata,
             _registered_method=True)
+
+
+class WorkerBrokerStub(object):
+    """WorkerBroker is the GRPC worker transport, calls select their pool with the
+    foreverbull-pool metadata key.
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.Connect = channel.stream_stream(
+                '/foreverbull.service.WorkerBroker/Connect',
+                request_serializer=foreverbull_dot_service_dot_worker__service__pb2.WorkerResponse.SerializeToString,
+                response_deserializer=foreverbull_dot_service_dot_worker__service__pb2.WorkerRequest.FromString,
+                _registered_method=True)
+        self.Namespace = channel.unary_unary(
+                '/foreverbull.service.WorkerBroker/Namespace',
+                request_serializer=foreverbull_dot_service_dot_worker__service__pb2.NamespaceRequest.SerializeToString,
+                response_deserializer=foreverbull_dot_service_dot_worker__service__pb2.NamespaceResponse.FromString,
+                _registered_method=True)
+
+
+class WorkerBrokerServicer(object):
+    """WorkerBroker is the GRPC worker transport, calls select their pool with the
+    foreverbull-pool metadata key.
+    """
+
+    def Connect(self, request_iterator, context):
+        """Connect streams requests to the worker, the worker answers every request with
+        a response before it gets the next one.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Namespace(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_WorkerBrokerServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'Connect': grpc.stream_stream_rpc_method_handler(
+                    servicer.Connect,
+                    request_deserializer=foreverbull_dot_service_dot_worker__service__pb2.WorkerResponse.FromString,
+                    response_serializer=foreverbull_dot_service_dot_worker__service__pb2.WorkerRequest.SerializeToString,
+            ),
+            'Namespace': grpc.unary_unary_rpc_method_handler(
+                    servicer.Namespace,
+                    request_deserializer=foreverbull_dot_service_dot_worker__service__pb2.NamespaceRequest.FromString,
+                    response_serializer=foreverbull_dot_service_dot_worker__service__pb2.NamespaceResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'foreverbull.service.WorkerBroker', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+    server.add_registered_method_handlers('foreverbull.service.WorkerBroker', rpc_method_handlers)
+
+
+ # This class is part of an EXPERIMENTAL API.
+class WorkerBroker(object):
+    """WorkerBroker is the GRPC worker transport, calls select their pool with the
+    foreverbull-pool metadata key.
+    """
+
+    @staticmethod
+    def Connect(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_stream(
+            request_iterator,
+            target,
+            '/foreverbull.service.WorkerBroker/Connect',
+            foreverbull_dot_service_dot_worker__service__pb2.WorkerResponse.SerializeToString,
+            foreverbull_dot_service_dot_worker__service__pb2.WorkerRequest.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Namespace(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/foreverbull.service.WorkerBroker/Namespace',
+            foreverbull_dot_service_dot_worker__service__pb2.NamespaceRequest.SerializeToString,
+            foreverbull_dot_service_dot_worker__service__pb2.NamespaceResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -0,0 +1,69 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: foreverbull/stream/message_service.proto
+# Protobuf Python Version: 5.27.2
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    5,
+    27,
+    2,
+    '',
+    'foreverbull/stream/message_service.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from foreverbull.pb.foreverbull.stream import orchestration_pb2 as foreverbull_dot_stream_dot_orchestration__pb2
+from foreverbull.pb.buf.validate import validate_pb2 as buf_dot_validate_dot_validate__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n(foreverbull/stream/message_service.proto\x12\x12\x66oreverbull.stream\x1a&foreverbull/stream/orchestration.proto\x1a\x1b\x62uf/validate/validate.proto\"\xb6\x01\n\x13ListMessagesRequest\x12\x13\n\x06status\x18\x01 \x01(\tH\x00\x88\x01\x01\x12\x13\n\x06module\x18\x02 \x01(\tH\x01\x88\x01\x01\x12\x16\n\tcomponent\x18\x03 \x01(\tH\x02\x88\x01\x01\x12\x13\n\x06method\x18\x04 \x01(\tH\x03\x88\x01\x01\x12\x19\n\x05limit\x18\x05 \x01(\x05\x42\n\xbaH\x07\x1a\x05\x18\xe8\x07(\x00\x42\t\n\x07_statusB\t\n\x07_moduleB\x0c\n\n_componentB\t\n\x07_method\"E\n\x14ListMessagesResponse\x12-\n\x08\x63ommands\x18\x01 \x03(\x0b\x32\x1b.foreverbull.stream.Command\"H\n\x11GetMessageRequest\x12\x33\n\nmessage_id\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\"B\n\x12GetMessageResponse\x12,\n\x07\x63ommand\x18\x01 \x01(\x0b\x32\x1b.foreverbull.stream.Command\"[\n\x14ReplayMessageRequest\x12\x33\n\nmessage_id\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\x12\x0e\n\x06new_id\x18\x02 \x01(\x08\"E\n\x15ReplayMessageResponse\x12,\n\x07\x63ommand\x18\x01 \x01(\x0b\x32\x1b.foreverbull.stream.Command\"\x18\n\x16ListDeadLettersRequest\"\xb2\x01\n\x17ListDeadLettersResponse\x12L\n\x0c\x64\x65\x61\x64_letters\x18\x01 \x03(\x0b\x32\x36.foreverbull.stream.ListDeadLettersResponse.DeadLetter\x1aI\n\nDeadLetter\x12,\n\x07\x63ommand\x18\x01 \x01(\x0b\x32\x1b.foreverbull.stream.Command\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"O\n\x18RedriveDeadLetterRequest\x12\x33\n\nmessage_id\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\"I\n\x19RedriveDeadLetterResponse\x12,\n\x07\x63ommand\x18\x01 \x01(\x0b\x32\x1b.foreverbull.stream.Command2\x95\x04\n\x0fMessageServicer\x12\x61\n\x0cListMessages\x12\'.foreverbull.stream.ListMessagesRequest\x1a(.foreverbull.stream.ListMessagesResponse\x12[\n\nGetMessage\x12%.foreverbull.stream.GetMessageRequest\x1a&.foreverbull.stream.GetMessageResponse\x12\x64\n\rReplayMessage\x12(.foreverbull.stream.ReplayMessageRequest\x1a).foreverbull.stream.ReplayMessageResponse\x12j\n\x0fListDeadLetters\x12*.foreverbull.stream.ListDeadLettersRequest\x1a+.foreverbull.stream.ListDeadLettersResponse\x12p\n\x11RedriveDeadLetter\x12,.foreverbull.stream.RedriveDeadLetterRequest\x1a-.foreverbull.stream.RedriveDeadLetterResponseB1Z/github.com/lhjnilsson/foreverbull/pkg/pb/streamb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'foreverbull.stream.message_service_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'Z/github.com/lhjnilsson/foreverbull/pkg/pb/stream'
+  _globals['_LISTMESSAGESREQUEST'].fields_by_name['limit']._loaded_options = None
+  _globals['_LISTMESSAGESREQUEST'].fields_by_name['limit']._serialized_options = b'\272H\007\032\005\030\350\007(\000'
+  _globals['_GETMESSAGEREQUEST'].fields_by_name['message_id']._loaded_options = None
+  _globals['_GETMESSAGEREQUEST'].fields_by_name['message_id']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_REPLAYMESSAGEREQUEST'].fields_by_name['message_id']._loaded_options = None
+  _globals['_REPLAYMESSAGEREQUEST'].fields_by_name['message_id']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_REDRIVEDEADLETTERREQUEST'].fields_by_name['message_id']._loaded_options = None
+  _globals['_REDRIVEDEADLETTERREQUEST'].fields_by_name['message_id']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_LISTMESSAGESREQUEST']._serialized_start=134
+  _globals['_LISTMESSAGESREQUEST']._serialized_end=316
+  _globals['_LISTMESSAGESRESPONSE']._serialized_start=318
+  _globals['_LISTMESSAGESRESPONSE']._serialized_end=387
+  _globals['_GETMESSAGEREQUEST']._serialized_start=389
+  _globals['_GETMESSAGEREQUEST']._serialized_end=461
+  _globals['_GETMESSAGERESPONSE']._serialized_start=463
+  _globals['_GETMESSAGERESPONSE']._serialized_end=529
+  _globals['_REPLAYMESSAGEREQUEST']._serialized_start=531
+  _globals['_REPLAYMESSAGEREQUEST']._serialized_end=622
+  _globals['_REPLAYMESSAGERESPONSE']._serialized_start=624
+  _globals['_REPLAYMESSAGERESPONSE']._serialized_end=693
+  _globals['_LISTDEADLETTERSREQUEST']._serialized_start=695
+  _globals['_LISTDEADLETTERSREQUEST']._serialized_end=719
+  _globals['_LISTDEADLETTERSRESPONSE']._serialized_start=722
+  _globals['_LISTDEADLETTERSRESPONSE']._serialized_end=900
+  _globals['_LISTDEADLETTERSRESPONSE_DEADLETTER']._serialized_start=827
+  _globals['_LISTDEADLETTERSRESPONSE_DEADLETTER']._serialized_end=900
+  _globals['_REDRIVEDEADLETTERREQUEST']._serialized_start=902
+  _globals['_REDRIVEDEADLETTERREQUEST']._serialized_end=981
+  _globals['_REDRIVEDEADLETTERRESPONSE']._serialized_start=983
+  _globals['_REDRIVEDEADLETTERRESPONSE']._serialized_end=1056
+  _globals['_MESSAGESERVICER']._serialized_start=1059
+  _globals['_MESSAGESERVICER']._serialized_end=1592
+# @@protoc_insertion_point(module_scope)
@@ -0,0 +1,83 @@
+from foreverbull.pb.foreverbull.stream import orchestration_pb2 as _orchestration_pb2
+from foreverbull.pb.buf.validate import validate_pb2 as _validate_pb2
+from google.protobuf.internal import containers as _containers
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class ListMessagesRequest(_message.Message):
+    __slots__ = ("status", "module", "component", "method", "limit")
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    MODULE_FIELD_NUMBER: _ClassVar[int]
+    COMPONENT_FIELD_NUMBER: _ClassVar[int]
+    METHOD_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    status: str
+    module: str
+    component: str
+    method: str
+    limit: int
+    def __init__(self, status: _Optional[str] = ..., module: _Optional[str] = ..., component: _Optional[str] = ..., method: _Optional[str] = ..., limit: _Optional[int] = ...) -> None: ...
+
+class ListMessagesResponse(_message.Message):
+    __slots__ = ("commands",)
+    COMMANDS_FIELD_NUMBER: _ClassVar[int]
+    commands: _containers.RepeatedCompositeFieldContainer[_orchestration_pb2.Command]
+    def __init__(self, commands: _Optional[_Iterable[_Union[_orchestration_pb2.Command, _Mapping]]] = ...) -> None: ...
+
+class GetMessageRequest(_message.Message):
+    __slots__ = ("message_id",)
+    MESSAGE_ID_FIELD_NUMBER: _ClassVar[int]
+    message_id: str
+    def __init__(self, message_id: _Optional[str] = ...) -> None: ...
+
+class GetMessageResponse(_message.Message):
+    __slots__ = ("command",)
+    COMMAND_FIELD_NUMBER: _ClassVar[int]
+    command: _orchestration_pb2.Command
+    def __init__(self, command: _Optional[_Union[_orchestration_pb2.Command, _Mapping]] = ...) -> None: ...
+
+class ReplayMessageRequest(_message.Message):
+    __slots__ = ("message_id", "new_id")
+    MESSAGE_ID_FIELD_NUMBER: _ClassVar[int]
+    NEW_ID_FIELD_NUMBER: _ClassVar[int]
+    message_id: str
+    new_id: bool
+    def __init__(self, message_id: _Optional[str] = ..., new_id: bool = ...) -> None: ...
+
+class ReplayMessageResponse(_message.Message):
+    __slots__ = ("command",)
+    COMMAND_FIELD_NUMBER: _ClassVar[int]
+    command: _orchestration_pb2.Command
+    def __init__(self, command: _Optional[_Union[_orchestration_pb2.Command, _Mapping]] = ...) -> None: ...
+
+class ListDeadLettersRequest(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class ListDeadLettersResponse(_message.Message):
+    __slots__ = ("dead_letters",)
+    class DeadLetter(_message.Message):
+        __slots__ = ("command", "error")
+        COMMAND_FIELD_NUMBER: _ClassVar[int]
+        ERROR_FIELD_NUMBER: _ClassVar[int]
+        command: _orchestration_pb2.Command
+        error: str
+        def __init__(self, command: _Optional[_Union[_orchestration_pb2.Command, _Mapping]] = ..., error: _Optional[str] = ...) -> None: ...
+    DEAD_LETTERS_FIELD_NUMBER: _ClassVar[int]
+    dead_letters: _containers.RepeatedCompositeFieldContainer[ListDeadLettersResponse.DeadLetter]
+    def __init__(self, dead_letters: _Optional[_Iterable[_Union[ListDeadLettersResponse.DeadLetter, _Mapping]]] = ...) -> None: ...
+
+class RedriveDeadLetterRequest(_message.Message):
+    __slots__ = ("message_id",)
+    MESSAGE_ID_FIELD_NUMBER: _ClassVar[int]
+    message_id: str
+    def __init__(self, message_id: _Optional[str] = ...) -> None: ...
+
+class RedriveDeadLetterResponse(_message.Message):
+    __slots__ = ("command",)
+    COMMAND_FIELD_NUMBER: _ClassVar[int]
+    command: _orchestration_pb2.Command
+    def __init__(self, command: _Optional[_Union[_orchestration_pb2.Command, _Mapping]] = ...) -> None: ...
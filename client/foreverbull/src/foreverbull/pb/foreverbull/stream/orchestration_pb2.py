@@ -0,0 +1,46 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: foreverbull/stream/orchestration.proto
+# Protobuf Python Version: 5.27.2
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    5,
+    27,
+    2,
+    '',
+    'foreverbull/stream/orchestration.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n&foreverbull/stream/orchestration.proto\x12\x12\x66oreverbull.stream\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9f\x03\n\x07\x43ommand\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06module\x18\x02 \x01(\t\x12\x11\n\tcomponent\x18\x03 \x01(\t\x12\x0e\n\x06method\x18\x04 \x01(\t\x12\x0f\n\x07payload\x18\x05 \x01(\x0c\x12\x10\n\x08\x61ttempts\x18\x06 \x01(\x05\x12\x34\n\x08statuses\x18\x07 \x03(\x0b\x32\".foreverbull.stream.Command.Status\x12\x16\n\x0eschema_version\x18\x08 \x01(\x05\x12\x1d\n\x10orchestration_id\x18\t \x01(\tH\x00\x88\x01\x01\x12\x1f\n\x12orchestration_step\x18\n \x01(\tH\x01\x88\x01\x01\x1ax\n\x06Status\x12\x0e\n\x06status\x18\x01 \x01(\t\x12\x12\n\x05\x65rror\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x0f\n\x07\x61ttempt\x18\x03 \x01(\x05\x12/\n\x0boccurred_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x08\n\x06_errorB\x13\n\x11_orchestration_idB\x15\n\x13_orchestration_step\"\xa1\x01\n\x11OrchestrationStep\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0bstep_number\x18\x02 \x01(\x05\x12\x12\n\ndepends_on\x18\x03 \x03(\t\x12\x10\n\x08\x66\x61llback\x18\x04 \x01(\x08\x12-\n\x08\x63ommands\x18\x05 \x03(\x0b\x32\x1b.foreverbull.stream.Command\x12\x14\n\x0c\x63ompensation\x18\x06 \x01(\x08\"\x96\x02\n\rOrchestration\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x38\n\x06status\x18\x03 \x01(\x0e\x32(.foreverbull.stream.Orchestration.Status\x12.\n\ncreated_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x34\n\x05steps\x18\x05 \x03(\x0b\x32%.foreverbull.stream.OrchestrationStep\"K\n\x06Status\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tCOMPLETED\x10\x02\x12\n\n\x06\x46\x41ILED\x10\x03\x12\x0c\n\x08\x43\x41NCELED\x10\x04\x42\x31Z/github.com/lhjnilsson/foreverbull/pkg/pb/streamb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'foreverbull.stream.orchestration_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'Z/github.com/lhjnilsson/foreverbull/pkg/pb/stream'
+  _globals['_COMMAND']._serialized_start=96
+  _globals['_COMMAND']._serialized_end=511
+  _globals['_COMMAND_STATUS']._serialized_start=347
+  _globals['_COMMAND_STATUS']._serialized_end=467
+  _globals['_ORCHESTRATIONSTEP']._serialized_start=514
+  _globals['_ORCHESTRATIONSTEP']._serialized_end=675
+  _globals['_ORCHESTRATION']._serialized_start=678
+  _globals['_ORCHESTRATION']._serialized_end=956
+  _globals['_ORCHESTRATION_STATUS']._serialized_start=881
+  _globals['_ORCHESTRATION_STATUS']._serialized_end=956
+# @@protoc_insertion_point(module_scope)
@@ -0,0 +1,85 @@
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
+from google.protobuf.internal import containers as _containers
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class Command(_message.Message):
+    __slots__ = ("id", "module", "component", "method", "payload", "attempts", "statuses", "schema_version", "orchestration_id", "orchestration_step")
+    class Status(_message.Message):
+        __slots__ = ("status", "error", "attempt", "occurred_at")
+        STATUS_FIELD_NUMBER: _ClassVar[int]
+        ERROR_FIELD_NUMBER: _ClassVar[int]
+        ATTEMPT_FIELD_NUMBER: _ClassVar[int]
+        OCCURRED_AT_FIELD_NUMBER: _ClassVar[int]
+        status: str
+        error: str
+        attempt: int
+        occurred_at: _timestamp_pb2.Timestamp
+        def __init__(self, status: _Optional[str] = ..., error: _Optional[str] = ..., attempt: _Optional[int] = ..., occurred_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+    ID_FIELD_NUMBER: _ClassVar[int]
+    MODULE_FIELD_NUMBER: _ClassVar[int]
+    COMPONENT_FIELD_NUMBER: _ClassVar[int]
+    METHOD_FIELD_NUMBER: _ClassVar[int]
+    PAYLOAD_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    STATUSES_FIELD_NUMBER: _ClassVar[int]
+    SCHEMA_VERSION_FIELD_NUMBER: _ClassVar[int]
+    ORCHESTRATION_ID_FIELD_NUMBER: _ClassVar[int]
+    ORCHESTRATION_STEP_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    module: str
+    component: str
+    method: str
+    payload: bytes
+    attempts: int
+    statuses: _containers.RepeatedCompositeFieldContainer[Command.Status]
+    schema_version: int
+    orchestration_id: str
+    orchestration_step: str
+    def __init__(self, id: _Optional[str] = ..., module: _Optional[str] = ..., component: _Optional[str] = ..., method: _Optional[str] = ..., payload: _Optional[bytes] = ..., attempts: _Optional[int] = ..., statuses: _Optional[_Iterable[_Union[Command.Status, _Mapping]]] = ..., schema_version: _Optional[int] = ..., orchestration_id: _Optional[str] = ..., orchestration_step: _Optional[str] = ...) -> None: ...
+
+class OrchestrationStep(_message.Message):
+    __slots__ = ("name", "step_number", "depends_on", "fallback", "commands", "compensation")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    STEP_NUMBER_FIELD_NUMBER: _ClassVar[int]
+    DEPENDS_ON_FIELD_NUMBER: _ClassVar[int]
+    FALLBACK_FIELD_NUMBER: _ClassVar[int]
+    COMMANDS_FIELD_NUMBER: _ClassVar[int]
+    COMPENSATION_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    step_number: int
+    depends_on: _containers.RepeatedScalarFieldContainer[str]
+    fallback: bool
+    commands: _containers.RepeatedCompositeFieldContainer[Command]
+    compensation: bool
+    def __init__(self, name: _Optional[str] = ..., step_number: _Optional[int] = ..., depends_on: _Optional[_Iterable[str]] = ..., fallback: bool = ..., commands: _Optional[_Iterable[_Union[Command, _Mapping]]] = ..., compensation: bool = ...) -> None: ...
+
+class Orchestration(_message.Message):
+    __slots__ = ("id", "name", "status", "created_at", "steps")
+    class Status(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+        __slots__ = ()
+        CREATED: _ClassVar[Orchestration.Status]
+        RUNNING: _ClassVar[Orchestration.Status]
+        COMPLETED: _ClassVar[Orchestration.Status]
+        FAILED: _ClassVar[Orchestration.Status]
+        CANCELED: _ClassVar[Orchestration.Status]
+    CREATED: Orchestration.Status
+    RUNNING: Orchestration.Status
+    COMPLETED: Orchestration.Status
+    FAILED: Orchestration.Status
+    CANCELED: Orchestration.Status
+    ID_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    STEPS_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    name: str
+    status: Orchestration.Status
+    created_at: _timestamp_pb2.Timestamp
+    steps: _containers.RepeatedCompositeFieldContainer[OrchestrationStep]
+    def __init__(self, id: _Optional[str] = ..., name: _Optional[str] = ..., status: _Optional[_Union[Orchestration.Status, str]] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., steps: _Optional[_Iterable[_Union[OrchestrationStep, _Mapping]]] = ...) -> None: ...
@@ -0,0 +1,75 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: foreverbull/stream/orchestration_service.proto
+# Protobuf Python Version: 5.27.2
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    5,
+    27,
+    2,
+    '',
+    'foreverbull/stream/orchestration_service.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from foreverbull.pb.foreverbull.stream import orchestration_pb2 as foreverbull_dot_stream_dot_orchestration__pb2
+from foreverbull.pb.buf.validate import validate_pb2 as buf_dot_validate_dot_validate__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n.foreverbull/stream/orchestration_service.proto\x12\x12\x66oreverbull.stream\x1a&foreverbull/stream/orchestration.proto\x1a\x1b\x62uf/validate/validate.proto\"7\n\x19ListOrchestrationsRequest\x12\x11\n\x04name\x18\x01 \x01(\tH\x00\x88\x01\x01\x42\x07\n\x05_name\"W\n\x1aListOrchestrationsResponse\x12\x39\n\x0eorchestrations\x18\x01 \x03(\x0b\x32!.foreverbull.stream.Orchestration\"T\n\x17GetOrchestrationRequest\x12\x39\n\x10orchestration_id\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\"T\n\x18GetOrchestrationResponse\x12\x38\n\rorchestration\x18\x01 \x01(\x0b\x32!.foreverbull.stream.Orchestration\"V\n\x19WatchOrchestrationRequest\x12\x39\n\x10orchestration_id\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\"\x84\x01\n\x1aWatchOrchestrationResponse\x12\x38\n\rorchestration\x18\x01 \x01(\x0b\x32!.foreverbull.stream.Orchestration\x12,\n\x07\x63ommand\x18\x02 \x01(\x0b\x32\x1b.foreverbull.stream.Command\"W\n\x1aResumeOrchestrationRequest\x12\x39\n\x10orchestration_id\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\"W\n\x1bResumeOrchestrationResponse\x12\x38\n\rorchestration\x18\x01 \x01(\x0b\x32!.foreverbull.stream.Orchestration\"\x89\x01\n\x1dRetryOrchestrationStepRequest\x12\x39\n\x10orchestration_id\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\x12-\n\x04step\x18\x02 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\"Z\n\x1eRetryOrchestrationStepResponse\x12\x38\n\rorchestration\x18\x01 \x01(\x0b\x32!.foreverbull.stream.Orchestration\"W\n\x1a\x43\x61ncelOrchestrationRequest\x12\x39\n\x10orchestration_id\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\"W\n\x1b\x43\x61ncelOrchestrationResponse\x12\x38\n\rorchestration\x18\x01 \x01(\x0b\x32!.foreverbull.stream.Orchestration2\xe3\x05\n\x15OrchestrationServicer\x12s\n\x12ListOrchestrations\x12-.foreverbull.stream.ListOrchestrationsRequest\x1a..foreverbull.stream.ListOrchestrationsResponse\x12m\n\x10GetOrchestration\x12+.foreverbull.stream.GetOrchestrationRequest\x1a,.foreverbull.stream.GetOrchestrationResponse\x12u\n\x12WatchOrchestration\x12-.foreverbull.stream.WatchOrchestrationRequest\x1a..foreverbull.stream.WatchOrchestrationResponse0\x01\x12v\n\x13ResumeOrchestration\x12..foreverbull.stream.ResumeOrchestrationRequest\x1a/.foreverbull.stream.ResumeOrchestrationResponse\x12\x7f\n\x16RetryOrchestrationStep\x12\x31.foreverbull.stream.RetryOrchestrationStepRequest\x1a\x32.foreverbull.stream.RetryOrchestrationStepResponse\x12v\n\x13\x43\x61ncelOrchestration\x12..foreverbull.stream.CancelOrchestrationRequest\x1a/.foreverbull.stream.CancelOrchestrationResponseB1Z/github.com/lhjnilsson/foreverbull/pkg/pb/streamb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'foreverbull.stream.orchestration_service_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'Z/github.com/lhjnilsson/foreverbull/pkg/pb/stream'
+  _globals['_GETORCHESTRATIONREQUEST'].fields_by_name['orchestration_id']._loaded_options = None
+  _globals['_GETORCHESTRATIONREQUEST'].fields_by_name['orchestration_id']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_WATCHORCHESTRATIONREQUEST'].fields_by_name['orchestration_id']._loaded_options = None
+  _globals['_WATCHORCHESTRATIONREQUEST'].fields_by_name['orchestration_id']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_RESUMEORCHESTRATIONREQUEST'].fields_by_name['orchestration_id']._loaded_options = None
+  _globals['_RESUMEORCHESTRATIONREQUEST'].fields_by_name['orchestration_id']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_RETRYORCHESTRATIONSTEPREQUEST'].fields_by_name['orchestration_id']._loaded_options = None
+  _globals['_RETRYORCHESTRATIONSTEPREQUEST'].fields_by_name['orchestration_id']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_RETRYORCHESTRATIONSTEPREQUEST'].fields_by_name['step']._loaded_options = None
+  _globals['_RETRYORCHESTRATIONSTEPREQUEST'].fields_by_name['step']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_CANCELORCHESTRATIONREQUEST'].fields_by_name['orchestration_id']._loaded_options = None
+  _globals['_CANCELORCHESTRATIONREQUEST'].fields_by_name['orchestration_id']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_LISTORCHESTRATIONSREQUEST']._serialized_start=139
+  _globals['_LISTORCHESTRATIONSREQUEST']._serialized_end=194
+  _globals['_LISTORCHESTRATIONSRESPONSE']._serialized_start=196
+  _globals['_LISTORCHESTRATIONSRESPONSE']._serialized_end=283
+  _globals['_GETORCHESTRATIONREQUEST']._serialized_start=285
+  _globals['_GETORCHESTRATIONREQUEST']._serialized_end=369
+  _globals['_GETORCHESTRATIONRESPONSE']._serialized_start=371
+  _globals['_GETORCHESTRATIONRESPONSE']._serialized_end=455
+  _globals['_WATCHORCHESTRATIONREQUEST']._serialized_start=457
+  _globals['_WATCHORCHESTRATIONREQUEST']._serialized_end=543
+  _globals['_WATCHORCHESTRATIONRESPONSE']._serialized_start=546
+  _globals['_WATCHORCHESTRATIONRESPONSE']._serialized_end=678
+  _globals['_RESUMEORCHESTRATIONREQUEST']._serialized_start=680
+  _globals['_RESUMEORCHESTRATIONREQUEST']._serialized_end=767
+  _globals['_RESUMEORCHESTRATIONRESPONSE']._serialized_start=769
+  _globals['_RESUMEORCHESTRATIONRESPONSE']._serialized_end=856
+  _globals['_RETRYORCHESTRATIONSTEPREQUEST']._serialized_start=859
+  _globals['_RETRYORCHESTRATIONSTEPREQUEST']._serialized_end=996
+  _globals['_RETRYORCHESTRATIONSTEPRESPONSE']._serialized_start=998
+  _globals['_RETRYORCHESTRATIONSTEPRESPONSE']._serialized_end=1088
+  _globals['_CANCELORCHESTRATIONREQUEST']._serialized_start=1090
+  _globals['_CANCELORCHESTRATIONREQUEST']._serialized_end=1177
+  _globals['_CANCELORCHESTRATIONRESPONSE']._serialized_start=1179
+  _globals['_CANCELORCHESTRATIONRESPONSE']._serialized_end=1266
+  _globals['_ORCHESTRATIONSERVICER']._serialized_start=1269
+  _globals['_ORCHESTRATIONSERVICER']._serialized_end=2008
+# @@protoc_insertion_point(module_scope)
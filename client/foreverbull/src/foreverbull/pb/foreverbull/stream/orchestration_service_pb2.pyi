@@ -0,0 +1,84 @@
+from foreverbull.pb.foreverbull.stream import orchestration_pb2 as _orchestration_pb2
+from foreverbull.pb.buf.validate import validate_pb2 as _validate_pb2
+from google.protobuf.internal import containers as _containers
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class ListOrchestrationsRequest(_message.Message):
+    __slots__ = ("name",)
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    def __init__(self, name: _Optional[str] = ...) -> None: ...
+
+class ListOrchestrationsResponse(_message.Message):
+    __slots__ = ("orchestrations",)
+    ORCHESTRATIONS_FIELD_NUMBER: _ClassVar[int]
+    orchestrations: _containers.RepeatedCompositeFieldContainer[_orchestration_pb2.Orchestration]
+    def __init__(self, orchestrations: _Optional[_Iterable[_Union[_orchestration_pb2.Orchestration, _Mapping]]] = ...) -> None: ...
+
+class GetOrchestrationRequest(_message.Message):
+    __slots__ = ("orchestration_id",)
+    ORCHESTRATION_ID_FIELD_NUMBER: _ClassVar[int]
+    orchestration_id: str
+    def __init__(self, orchestration_id: _Optional[str] = ...) -> None: ...
+
+class GetOrchestrationResponse(_message.Message):
+    __slots__ = ("orchestration",)
+    ORCHESTRATION_FIELD_NUMBER: _ClassVar[int]
+    orchestration: _orchestration_pb2.Orchestration
+    def __init__(self, orchestration: _Optional[_Union[_orchestration_pb2.Orchestration, _Mapping]] = ...) -> None: ...
+
+class WatchOrchestrationRequest(_message.Message):
+    __slots__ = ("orchestration_id",)
+    ORCHESTRATION_ID_FIELD_NUMBER: _ClassVar[int]
+    orchestration_id: str
+    def __init__(self, orchestration_id: _Optional[str] = ...) -> None: ...
+
+class WatchOrchestrationResponse(_message.Message):
+    __slots__ = ("orchestration", "command")
+    ORCHESTRATION_FIELD_NUMBER: _ClassVar[int]
+    COMMAND_FIELD_NUMBER: _ClassVar[int]
+    orchestration: _orchestration_pb2.Orchestration
+    command: _orchestration_pb2.Command
+    def __init__(self, orchestration: _Optional[_Union[_orchestration_pb2.Orchestration, _Mapping]] = ..., command: _Optional[_Union[_orchestration_pb2.Command, _Mapping]] = ...) -> None: ...
+
+class ResumeOrchestrationRequest(_message.Message):
+    __slots__ = ("orchestration_id",)
+    ORCHESTRATION_ID_FIELD_NUMBER: _ClassVar[int]
+    orchestration_id: str
+    def __init__(self, orchestration_id: _Optional[str] = ...) -> None: ...
+
+class ResumeOrchestrationResponse(_message.Message):
+    __slots__ = ("orchestration",)
+    ORCHESTRATION_FIELD_NUMBER: _ClassVar[int]
+    orchestration: _orchestration_pb2.Orchestration
+    def __init__(self, orchestration: _Optional[_Union[_orchestration_pb2.Orchestration, _Mapping]] = ...) -> None: ...
+
+class RetryOrchestrationStepRequest(_message.Message):
+    __slots__ = ("orchestration_id", "step")
+    ORCHESTRATION_ID_FIELD_NUMBER: _ClassVar[int]
+    STEP_FIELD_NUMBER: _ClassVar[int]
+    orchestration_id: str
+    step: str
+    def __init__(self, orchestration_id: _Optional[str] = ..., step: _Optional[str] = ...) -> None: ...
+
+class RetryOrchestrationStepResponse(_message.Message):
+    __slots__ = ("orchestration",)
+    ORCHESTRATION_FIELD_NUMBER: _ClassVar[int]
+    orchestration: _orchestration_pb2.Orchestration
+    def __init__(self, orchestration: _Optional[_Union[_orchestration_pb2.Orchestration, _Mapping]] = ...) -> None: ...
+
+class CancelOrchestrationRequest(_message.Message):
+    __slots__ = ("orchestration_id",)
+    ORCHESTRATION_ID_FIELD_NUMBER: _ClassVar[int]
+    orchestration_id: str
+    def __init__(self, orchestration_id: _Optional[str] = ...) -> None: ...
+
+class CancelOrchestrationResponse(_message.Message):
+    __slots__ = ("orchestration",)
+    ORCHESTRATION_FIELD_NUMBER: _ClassVar[int]
+    orchestration: _orchestration_pb2.Orchestration
+    def __init__(self, orchestration: _Optional[_Union[_orchestration_pb2.Orchestration, _Mapping]] = ...) -> None: ...
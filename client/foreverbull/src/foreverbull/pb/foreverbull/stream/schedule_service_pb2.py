@@ -0,0 +1,63 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: foreverbull/stream/schedule_service.proto
+# Protobuf Python Version: 5.27.2
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    5,
+    27,
+    2,
+    '',
+    'foreverbull/stream/schedule_service.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from foreverbull.pb.foreverbull.stream import schedule_pb2 as foreverbull_dot_stream_dot_schedule__pb2
+from foreverbull.pb.buf.validate import validate_pb2 as buf_dot_validate_dot_validate__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n)foreverbull/stream/schedule_service.proto\x12\x12\x66oreverbull.stream\x1a!foreverbull/stream/schedule.proto\x1a\x1b\x62uf/validate/validate.proto\"\x16\n\x14ListSchedulesRequest\"H\n\x15ListSchedulesResponse\x12/\n\tschedules\x18\x01 \x03(\x0b\x32\x1c.foreverbull.stream.Schedule\"\x9c\x02\n\x15\x43reateScheduleRequest\x12-\n\x04name\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\x12-\n\x04\x63ron\x18\x02 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\x12/\n\x06module\x18\x03 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\x12\x32\n\tcomponent\x18\x04 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\x12/\n\x06method\x18\x05 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\x12\x0f\n\x07payload\x18\x06 \x01(\x0c\"H\n\x16\x43reateScheduleResponse\x12.\n\x08schedule\x18\x01 \x01(\x0b\x32\x1c.foreverbull.stream.Schedule\"F\n\x15\x44\x65leteScheduleRequest\x12-\n\x04name\x18\x01 \x01(\tB\x1f\xbaH\x1c\xba\x01\x16\n\x08required\x1a\nthis != \'\'\xc8\x01\x01\"\x18\n\x16\x44\x65leteScheduleResponse2\xca\x02\n\x10ScheduleServicer\x12\x64\n\rListSchedules\x12(.foreverbull.stream.ListSchedulesRequest\x1a).foreverbull.stream.ListSchedulesResponse\x12g\n\x0e\x43reateSchedule\x12).foreverbull.stream.CreateScheduleRequest\x1a*.foreverbull.stream.CreateScheduleResponse\x12g\n\x0e\x44\x65leteSchedule\x12).foreverbull.stream.DeleteScheduleRequest\x1a*.foreverbull.stream.DeleteScheduleResponseB1Z/github.com/lhjnilsson/foreverbull/pkg/pb/streamb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'foreverbull.stream.schedule_service_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'Z/github.com/lhjnilsson/foreverbull/pkg/pb/stream'
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['name']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['cron']._loaded_options = None
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['cron']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['module']._loaded_options = None
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['module']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['component']._loaded_options = None
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['component']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['method']._loaded_options = None
+  _globals['_CREATESCHEDULEREQUEST'].fields_by_name['method']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_DELETESCHEDULEREQUEST'].fields_by_name['name']._loaded_options = None
+  _globals['_DELETESCHEDULEREQUEST'].fields_by_name['name']._serialized_options = b'\272H\034\272\001\026\n\010required\032\nthis != \'\'\310\001\001'
+  _globals['_LISTSCHEDULESREQUEST']._serialized_start=129
+  _globals['_LISTSCHEDULESREQUEST']._serialized_end=151
+  _globals['_LISTSCHEDULESRESPONSE']._serialized_start=153
+  _globals['_LISTSCHEDULESRESPONSE']._serialized_end=225
+  _globals['_CREATESCHEDULEREQUEST']._serialized_start=228
+  _globals['_CREATESCHEDULEREQUEST']._serialized_end=512
+  _globals['_CREATESCHEDULERESPONSE']._serialized_start=514
+  _globals['_CREATESCHEDULERESPONSE']._serialized_end=586
+  _globals['_DELETESCHEDULEREQUEST']._serialized_start=588
+  _globals['_DELETESCHEDULEREQUEST']._serialized_end=658
+  _globals['_DELETESCHEDULERESPONSE']._serialized_start=660
+  _globals['_DELETESCHEDULERESPONSE']._serialized_end=684
+  _globals['_SCHEDULESERVICER']._serialized_start=687
+  _globals['_SCHEDULESERVICER']._serialized_end=1017
+# @@protoc_insertion_point(module_scope)
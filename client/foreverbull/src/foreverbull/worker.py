@@ -52,29 +52,45 @@ class WorkerInstance(Worker):
             raise exceptions.ConfigurationError(f"Unable to load algorithm: {e}")
 
         _hostname = os.getenv("BROKER_HOSTNAME", "127.0.0.1")
+        _scheme = "tcp"
+        _socket_options = {}
+        if req.HasField("credentials"):
+            _scheme = "tls+tcp"
+            try:
+                _socket_options["tls_config"] = pynng.TLSConfig(
+                    pynng.TLSConfig.MODE_CLIENT,
+                    server_name=req.credentials.serverName,
+                    ca_string=req.credentials.caCertificate.decode(),
+                    own_cert_string=req.credentials.certificate.decode(),
+                    own_key_string=req.credentials.privateKey.decode(),
+                )
+            except Exception as e:
+                raise exceptions.ConfigurationError(f"Unable to configure TLS: {e}")
         try:
             self._broker_socket = pynng.Rep0(
-                dial=f"tcp://{_hostname}:{req.brokerPort}",
+                dial=f"{_scheme}://{_hostname}:{req.brokerPort}",
                 block_on_dial=True,
                 recv_timeout=500,
                 send_timeout=500,
+                **_socket_options,
             )
-            self.logger.info(f"connected to broker socket: tcp://{_hostname}:{req.brokerPort}")
+            self.logger.info(f"connected to broker socket: {_scheme}://{_hostname}:{req.brokerPort}")
         except Exception as e:
             raise exceptions.ConfigurationError(f"Unable to connect to broker: {e}")
         try:
             self._namespace_socket = pynng.Req0(
-                dial=f"tcp://{_hostname}:{req.namespacePort}",
+                dial=f"{_scheme}://{_hostname}:{req.namespacePort}",
                 block_on_dial=True,
                 recv_timeout=500,
                 send_timeout=500,
+                **_socket_options,
             )
-            self.logger.info(f"connected to namespace socket: tcp://{_hostname}:{req.namespacePort}")
+            self.logger.info(f"connected to namespace socket: {_scheme}://{_hostname}:{req.namespacePort}")
         except Exception as e:
             raise exceptions.ConfigurationError(f"Unable to connect to namespace: {e}")
 
         try:
-            engine = get_engine(req.databaseURL)
+            engine = get_engine(os.environ["DATABASE_URL"])
             with engine.connect() as connection:
                 connection.execute(sqlalchemy.text("SELECT 1 from asset;"))
             self._database_engine = engine
@@ -108,6 +124,10 @@ class WorkerInstance(Worker):
                 request = worker_service_pb2.WorkerRequest()
                 request.ParseFromString(context_socket.recv())
                 response = worker_service_pb2.WorkerResponse(task=request.task, error=None)
+                if request.task == "heartbeat":
+                    context_socket.send(response.SerializeToString())
+                    context_socket.close()
+                    continue
                 self.logger.debug(f"Processing {request.portfolio.timestamp} symbols: {request.symbols}")
                 with self._database_engine.connect() as db:
                     orders = self._algo.process(
@@ -116,6 +116,30 @@ def namespace_server():
     thread.join()
 
 
+@pytest.fixture(scope="session")
+def tls_credentials():
+    certs = os.path.join(os.path.dirname(__file__), "certs")
+
+    def read(name: str) -> bytes:
+        with open(os.path.join(certs, name), "rb") as f:
+            return f.read()
+
+    server_config = pynng.TLSConfig(
+        pynng.TLSConfig.MODE_SERVER,
+        ca_string=read("ca.pem").decode(),
+        own_cert_string=read("server.pem").decode(),
+        own_key_string=read("server.key").decode(),
+        auth_mode=pynng.TLSConfig.AUTH_MODE_REQUIRED,
+    )
+    credentials = worker_pb2.ExecutionConfiguration.Credentials(
+        serverName="foreverbull-pool",
+        caCertificate=read("ca.pem"),
+        certificate=read("client.pem"),
+        privateKey=read("client.key"),
+    )
+    return server_config, credentials
+
+
 def get_algo(file_path: str) -> Algorithm:
     spec = importlib.util.spec_from_file_location(
         "",
@@ -167,7 +191,6 @@ def parallel_algo_file(spawn_process, execution: execution_pb2.Execution, fb_dat
     request = worker_pb2.ExecutionConfiguration(
         brokerPort=5656,
         namespacePort=7878,
-        databaseURL=os.environ["DATABASE_URL"],
         functions=[],
     )
 
@@ -236,7 +259,6 @@ def non_parallel_algo_file(spawn_process, execution: execution_pb2.Execution, fb
     request = worker_pb2.ExecutionConfiguration(
         brokerPort=5657,
         namespacePort=7878,
-        databaseURL=os.environ["DATABASE_URL"],
         functions=[],
     )
 
@@ -306,7 +328,6 @@ def parallel_algo_file_with_parameters(spawn_process, execution: execution_pb2.E
     request = worker_pb2.ExecutionConfiguration(
         brokerPort=5658,
         namespacePort=7878,
-        databaseURL=os.environ["DATABASE_URL"],
         functions=[
             worker_pb2.ExecutionConfiguration.Function(
                 name="parallel_algo_with_parameters",
@@ -382,7 +403,6 @@ def non_parallel_algo_file_with_parameters(spawn_process, execution: execution_p
     request = worker_pb2.ExecutionConfiguration(
         brokerPort=5659,
         namespacePort=7878,
-        databaseURL=os.environ["DATABASE_URL"],
         functions=[
             worker_pb2.ExecutionConfiguration.Function(
                 name="non_parallel_algo_with_parameters",
@@ -476,7 +496,6 @@ def multistep_algo_with_namespace(spawn_process, execution: execution_pb2.Execut
     request = worker_pb2.ExecutionConfiguration(
         brokerPort=5660,
         namespacePort=7878,
-        databaseURL=os.environ["DATABASE_URL"],
         functions=[
             worker_pb2.ExecutionConfiguration.Function(
                 name="measure_assets",
@@ -1,6 +1,7 @@
 from multiprocessing import Event
 from threading import Thread
 
+import pynng
 import pytest
 
 from foreverbull import exceptions
@@ -28,17 +29,38 @@ class TestWorkerInstance:
         with pytest.raises(exceptions.ConfigurationError):
             w.configure_execution(request)
 
-    def test_configure_bad_database(self, parallel_algo_file):
+    def test_configure_bad_database(self, monkeypatch, parallel_algo_file):
         algorithm, request, _ = parallel_algo_file
         w = worker.WorkerInstance(algorithm._file_path)
-        request.databaseURL = "bad_url"
+        monkeypatch.setenv("DATABASE_URL", "bad_url")
         with pytest.raises(exceptions.ConfigurationError):
             w.configure_execution(request)
 
-    def test_configure_bad_parameters(self, parallel_algo_file):
+    def test_configure_bad_parameters(self, monkeypatch, parallel_algo_file):
         algorithm, request, _ = parallel_algo_file
         w = worker.WorkerInstance(algorithm._file_path)
-        request.databaseURL = "bad_url"
+        monkeypatch.setenv("DATABASE_URL", "bad_url")
+        with pytest.raises(exceptions.ConfigurationError):
+            w.configure_execution(request)
+
+    def test_configure_tls(self, parallel_algo_file, tls_credentials):
+        algorithm, request, _ = parallel_algo_file
+        server_config, credentials = tls_credentials
+        request.brokerPort = 5661
+        request.namespacePort = 7879
+        request.credentials.CopyFrom(credentials)
+        with pynng.Req0(listen="tls+tcp://127.0.0.1:5661", tls_config=server_config):
+            with pynng.Rep0(listen="tls+tcp://127.0.0.1:7879", tls_config=server_config):
+                w = worker.WorkerInstance(algorithm._file_path)
+                w.configure_execution(request)
+                assert w.is_configured
+
+    def test_configure_tls_bad_credentials(self, parallel_algo_file, tls_credentials):
+        algorithm, request, _ = parallel_algo_file
+        _, credentials = tls_credentials
+        request.credentials.CopyFrom(credentials)
+        request.credentials.caCertificate = b"bad_certificate"
+        w = worker.WorkerInstance(algorithm._file_path)
         with pytest.raises(exceptions.ConfigurationError):
             w.configure_execution(request)
 
@@ -78,6 +100,7 @@ class TestWorkerPool:
     )
     def test_configure(
         self,
+        monkeypatch,
         namespace_server,
         parallel_algo_file,
         broker_port,
@@ -91,7 +114,7 @@ class TestWorkerPool:
         if namespace_port:
             request.namespacePort = namespace_port
         if database_url:
-            request.databaseURL = database_url
+            monkeypatch.setenv("DATABASE_URL", database_url)
         pool = worker.WorkerPool(algorithm._file_path)
         with pool:
             if expected_exception:
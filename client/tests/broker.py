@@ -1,5 +1,3 @@
-import os
-
 from typing import Generator
 
 import pynng
@@ -86,7 +84,6 @@ class Broker:
             configuration=worker_pb2.ExecutionConfiguration(
                 brokerPort=self._broker_port,
                 namespacePort=self._namespace_port,
-                databaseURL=os.getenv("DATABASE_URL"),
                 functions=[],
             )
         )
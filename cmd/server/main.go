@@ -64,6 +64,8 @@ func app() *fx.App {
 		service.Module,
 		strategy.Module,
 		stream.OrchestrationLifecycle,
+		stream.JanitorLifecycle,
+		stream.OrchestrationServicerModule,
 	)
 }
 
@@ -0,0 +1,237 @@
+package ports
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrNoFreePorts = errors.New("no free ports in range")
+	// ErrPortInUse is returned by a BindFunc when the port is taken outside of the
+	// allocator, the allocator moves on to the next port.
+	ErrPortInUse = errors.New("port in use")
+)
+
+type OwnerKind string
+
+const (
+	Session   OwnerKind = "session"
+	Execution OwnerKind = "execution"
+	Pool      OwnerKind = "pool"
+)
+
+// Owner is the component holding a lease.
+type Owner struct {
+	Kind OwnerKind
+	ID   string
+}
+
+func (o Owner) String() string {
+	return fmt.Sprintf("%s/%s", o.Kind, o.ID)
+}
+
+// BindFunc binds the port for the lease, errors other than the port being in use stop
+// the allocation.
+type BindFunc func(port int) error
+
+type Lease struct {
+	Port     int
+	Owner    Owner
+	Acquired time.Time
+	// Expires is zero for leases that are only released by Release.
+	Expires time.Time
+
+	allocator *Allocator
+	timer     *time.Timer
+	onExpire  func()
+}
+
+func (l *Lease) expired(now time.Time) bool {
+	return !l.Expires.IsZero() && now.After(l.Expires)
+}
+
+// Renew makes the lease expire ttl from now.
+func (l *Lease) Renew(ttl time.Duration) {
+	if l == nil || l.allocator == nil {
+		return
+	}
+
+	l.allocator.lock.Lock()
+	defer l.allocator.lock.Unlock()
+
+	l.Expires = time.Now().Add(ttl)
+
+	if l.timer != nil {
+		l.timer.Reset(ttl)
+	}
+}
+
+// OnExpire sets what closes the owner of the port once the lease expires, an expired
+// lease stays listed until it has been called.
+func (l *Lease) OnExpire(f func()) {
+	if l == nil || l.allocator == nil {
+		return
+	}
+
+	l.allocator.lock.Lock()
+	defer l.allocator.lock.Unlock()
+
+	l.onExpire = f
+}
+
+// Release hands the port back to the allocator, releasing twice, a nil lease or a
+// copy from Leases is a no-op.
+func (l *Lease) Release() {
+	if l == nil || l.allocator == nil {
+		return
+	}
+
+	l.allocator.lock.Lock()
+	defer l.allocator.lock.Unlock()
+
+	if l.allocator.leases[l.Port] == l {
+		delete(l.allocator.leases, l.Port)
+	}
+
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+}
+
+// expire closes the owner of a lease that was not renewed in time and hands the port
+// back to the allocator.
+func (l *Lease) expire() {
+	l.allocator.lock.Lock()
+
+	if l.allocator.leases[l.Port] != l {
+		l.allocator.lock.Unlock()
+		return
+	}
+
+	if now := time.Now(); !l.expired(now) {
+		l.timer.Reset(l.Expires.Sub(now))
+		l.allocator.lock.Unlock()
+
+		return
+	}
+
+	onExpire := l.onExpire
+	l.allocator.lock.Unlock()
+
+	log.Warn().Int("port", l.Port).Str("owner", l.Owner.String()).Msg("port lease expired")
+
+	if onExpire != nil {
+		onExpire()
+	}
+
+	l.Release()
+}
+
+// Allocator hands out ports from a range, so components in the same process never race
+// for the same port and every port in use has a known owner.
+type Allocator struct {
+	lock   sync.Mutex
+	start  int
+	end    int
+	leases map[int]*Lease
+}
+
+func NewAllocator(start, end int) *Allocator {
+	return &Allocator{
+		start:  start,
+		end:    end,
+		leases: make(map[int]*Lease),
+	}
+}
+
+var (
+	defaultAllocator     *Allocator //nolint: gochecknoglobals
+	defaultAllocatorOnce sync.Once  //nolint: gochecknoglobals
+)
+
+// Default is the allocator for the backtest port range of the environment.
+func Default() *Allocator {
+	defaultAllocatorOnce.Do(func() {
+		defaultAllocator = NewAllocator(environment.GetBacktestPortRangeStart(), environment.GetBacktestPortRangeEnd())
+	})
+
+	return defaultAllocator
+}
+
+func (a *Allocator) Range() (int, int) {
+	return a.start, a.end
+}
+
+// Acquire leases the first free port that bind succeeds on. A ttl of zero keeps the
+// lease until it is released, otherwise it expires unless renewed and the port is handed
+// out again once OnExpire has closed its owner.
+func (a *Allocator) Acquire(owner Owner, ttl time.Duration, bind BindFunc) (*Lease, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := time.Now()
+
+	for port := a.start; port <= a.end; port++ {
+		if _, leased := a.leases[port]; leased {
+			continue
+		}
+
+		err := bind(port)
+		if errors.Is(err, ErrPortInUse) || errors.Is(err, syscall.EADDRINUSE) {
+			log.Debug().Int("port", port).Msg("port already in use, trying next port")
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error binding port %d: %w", port, err)
+		}
+
+		lease := &Lease{
+			Port:      port,
+			Owner:     owner,
+			Acquired:  now,
+			allocator: a,
+		}
+		if ttl > 0 {
+			lease.Expires = now.Add(ttl)
+			lease.timer = time.AfterFunc(ttl, lease.expire)
+		}
+
+		a.leases[port] = lease
+
+		return lease, nil
+	}
+
+	return nil, fmt.Errorf("%w %d-%d", ErrNoFreePorts, a.start, a.end)
+}
+
+// Leases returns a copy of the leases ordered by port, including expired leases whose
+// owner is still being closed.
+func (a *Allocator) Leases() []Lease {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	leases := make([]Lease, 0, len(a.leases))
+
+	for _, lease := range a.leases {
+		leases = append(leases, Lease{
+			Port:     lease.Port,
+			Owner:    lease.Owner,
+			Acquired: lease.Acquired,
+			Expires:  lease.Expires,
+		})
+	}
+
+	slices.SortFunc(leases, func(a, b Lease) int {
+		return a.Port - b.Port
+	})
+
+	return leases
+}
@@ -0,0 +1,174 @@
+package ports
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AllocatorTest struct {
+	suite.Suite
+
+	allocator *Allocator
+	owner     Owner
+}
+
+func (test *AllocatorTest) SetupTest() {
+	test.allocator = NewAllocator(10000, 10002)
+	test.owner = Owner{Kind: Session, ID: "test"}
+}
+
+func TestAllocator(t *testing.T) {
+	suite.Run(t, new(AllocatorTest))
+}
+
+func bindAny(int) error {
+	return nil
+}
+
+func (test *AllocatorTest) TestAcquireRelease() {
+	first, err := test.allocator.Acquire(test.owner, 0, bindAny)
+	test.Require().NoError(err)
+	test.Equal(10000, first.Port)
+	test.Equal(test.owner, first.Owner)
+	test.Zero(first.Expires)
+
+	second, err := test.allocator.Acquire(Owner{Kind: Pool, ID: "pool"}, 0, bindAny)
+	test.Require().NoError(err)
+	test.Equal(10001, second.Port)
+
+	first.Release()
+	first.Release()
+
+	third, err := test.allocator.Acquire(Owner{Kind: Execution, ID: "execution"}, 0, bindAny)
+	test.Require().NoError(err)
+	test.Equal(10000, third.Port)
+
+	// Releasing an old lease must not release the port for its new owner
+	first.Release()
+	test.Len(test.allocator.Leases(), 2)
+
+	var lease *Lease
+	lease.Release()
+	lease.Renew(time.Minute)
+}
+
+func (test *AllocatorTest) TestBindErrors() {
+	test.Run("in use", func() {
+		lease, err := test.allocator.Acquire(test.owner, 0, func(port int) error {
+			switch port {
+			case 10000:
+				return ErrPortInUse
+			case 10001:
+				return syscall.EADDRINUSE
+			}
+
+			return nil
+		})
+		test.Require().NoError(err)
+		test.Equal(10002, lease.Port)
+		lease.Release()
+	})
+	test.Run("failure", func() {
+		bindErr := errors.New("bind failed")
+		_, err := test.allocator.Acquire(test.owner, 0, func(int) error {
+			return bindErr
+		})
+		test.ErrorIs(err, bindErr)
+		test.Empty(test.allocator.Leases())
+	})
+	test.Run("no free ports", func() {
+		for range 3 {
+			_, err := test.allocator.Acquire(test.owner, 0, bindAny)
+			test.Require().NoError(err)
+		}
+
+		_, err := test.allocator.Acquire(test.owner, 0, bindAny)
+		test.ErrorIs(err, ErrNoFreePorts)
+	})
+}
+
+func (test *AllocatorTest) TestExpiry() {
+	expiring, err := test.allocator.Acquire(test.owner, time.Millisecond*50, bindAny)
+	test.Require().NoError(err)
+	test.False(expiring.Expires.IsZero())
+
+	closing := make(chan struct{})
+	closed := make(chan struct{})
+	expiring.OnExpire(func() {
+		close(closing)
+		<-closed
+	})
+
+	renewed, err := test.allocator.Acquire(test.owner, time.Millisecond*50, bindAny)
+	test.Require().NoError(err)
+
+	time.Sleep(time.Millisecond * 30)
+	renewed.Renew(time.Minute)
+
+	select {
+	case <-closing:
+	case <-time.After(time.Second):
+		test.Fail("owner of expired lease not closed")
+	}
+
+	// The port is not handed out while its owner is closed
+	test.Len(test.allocator.Leases(), 2)
+	other, err := test.allocator.Acquire(Owner{Kind: Pool, ID: "pool"}, 0, bindAny)
+	test.Require().NoError(err)
+	test.NotEqual(expiring.Port, other.Port)
+	other.Release()
+
+	close(closed)
+	test.Eventually(func() bool {
+		return len(test.allocator.Leases()) == 1
+	}, time.Second, time.Millisecond*10)
+
+	leases := test.allocator.Leases()
+	test.Require().Len(leases, 1)
+	test.Equal(renewed.Port, leases[0].Port)
+
+	// The expired port is handed out again
+	reclaimed, err := test.allocator.Acquire(Owner{Kind: Pool, ID: "pool"}, 0, bindAny)
+	test.Require().NoError(err)
+	test.Equal(expiring.Port, reclaimed.Port)
+
+	expiring.Release()
+	test.Len(test.allocator.Leases(), 2)
+}
+
+func (test *AllocatorTest) TestLeases() {
+	owners := []Owner{
+		{Kind: Session, ID: "session"},
+		{Kind: Execution, ID: "execution"},
+		{Kind: Pool, ID: "pool"},
+	}
+
+	for _, owner := range owners {
+		_, err := test.allocator.Acquire(owner, time.Minute, bindAny)
+		test.Require().NoError(err)
+	}
+
+	leases := test.allocator.Leases()
+	test.Require().Len(leases, 3)
+
+	for i, lease := range leases {
+		test.Equal(10000+i, lease.Port)
+		test.Equal(owners[i], lease.Owner)
+		test.False(lease.Acquired.IsZero())
+		test.False(lease.Expires.IsZero())
+
+		// Copies can not release the lease
+		lease.Release()
+	}
+
+	test.Len(test.allocator.Leases(), 3)
+
+	start, end := test.allocator.Range()
+	test.Equal(10000, start)
+	test.Equal(10002, end)
+	test.Equal("session/session", owners[0].String())
+}
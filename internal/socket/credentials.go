@@ -0,0 +1,188 @@
+package socket
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const serialNumberBits = 128
+
+// Credentials hold PEM encoded certificates for one listener and its peers. Both the
+// server and the client certificate are signed by a CA created for these credentials
+// only, so they are useless for any other socket.
+type Credentials struct {
+	ServerName string
+	CA         []byte
+	ServerCert []byte
+	ServerKey  []byte
+	ClientCert []byte
+	ClientKey  []byte
+}
+
+type certificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newCertificate(template *x509.Certificate, parent *certificate) (*certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	template.SerialNumber, err = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	return &certificate{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}, nil
+}
+
+func (c *certificate) keyPEM() ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+// NewCredentials creates a CA with a server certificate for serverName and a client
+// certificate, valid for the given duration.
+func NewCredentials(serverName string, validity time.Duration) (*Credentials, error) {
+	notBefore := time.Now().Add(-time.Minute)
+	notAfter := notBefore.Add(validity)
+
+	ca, err := newCertificate(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: serverName + " CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CA: %w", err)
+	}
+
+	server, err := newCertificate(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: serverName},
+		DNSNames:    []string{serverName},
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	if err != nil {
+		return nil, fmt.Errorf("error creating server certificate: %w", err)
+	}
+
+	client, err := newCertificate(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: serverName + " client"},
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+	if err != nil {
+		return nil, fmt.Errorf("error creating client certificate: %w", err)
+	}
+
+	serverKey, err := server.keyPEM()
+	if err != nil {
+		return nil, err
+	}
+
+	clientKey, err := client.keyPEM()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Credentials{
+		ServerName: serverName,
+		CA:         ca.pem,
+		ServerCert: server.pem,
+		ServerKey:  serverKey,
+		ClientCert: client.pem,
+		ClientKey:  clientKey,
+	}, nil
+}
+
+func certPool(ca []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("invalid CA certificate")
+	}
+
+	return pool, nil
+}
+
+// ServerTLS is the config of the listener, peers without a client certificate signed
+// by the CA are rejected.
+func (c *Credentials) ServerTLS() (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(c.ServerCert, c.ServerKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	pool, err := certPool(c.CA)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ClientTLS is the config peers dial with.
+func (c *Credentials) ClientTLS() (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(c.ClientCert, c.ClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+
+	pool, err := certPool(c.CA)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   c.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -3,6 +3,8 @@
 package socket
 
 import (
+	time "time"
+
 	mock "github.com/stretchr/testify/mock"
 	proto "google.golang.org/protobuf/proto"
 )
@@ -66,6 +68,44 @@ func (_m *MockRequester) GetPort() int {
 	return r0
 }
 
+// Peers provides a mock function with given fields:
+func (_m *MockRequester) Peers() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Peers")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Replies provides a mock function with given fields:
+func (_m *MockRequester) Replies() map[uint32]time.Time {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replies")
+	}
+
+	var r0 map[uint32]time.Time
+	if rf, ok := ret.Get(0).(func() map[uint32]time.Time); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint32]time.Time)
+		}
+	}
+
+	return r0
+}
+
 // Request provides a mock function with given fields: msg, reply, opts
 func (_m *MockRequester) Request(msg proto.Message, reply proto.Message, opts ...func(OptionSetter) error) error {
 	_va := make([]interface{}, len(opts))
@@ -1,14 +1,16 @@
 package socket
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
-	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/lhjnilsson/foreverbull/internal/environment"
-	"github.com/rs/zerolog/log"
+	"github.com/lhjnilsson/foreverbull/internal/ports"
 	"go.nanomsg.org/mangos/v3"
+	"go.nanomsg.org/mangos/v3/protocol/pub"
 	"go.nanomsg.org/mangos/v3/protocol/rep"
 	"go.nanomsg.org/mangos/v3/protocol/req"
 	"go.nanomsg.org/mangos/v3/protocol/sub"
@@ -22,6 +24,7 @@ var (
 	ErrClosed      = errors.New("socket closed")
 	ErrReadTimeout = errors.New("read timeout")
 	ErrSendTimeout = errors.New("send timeout")
+	ErrNoPeers     = errors.New("no peers connected")
 )
 
 func sockError(err error) error {
@@ -32,6 +35,8 @@ func sockError(err error) error {
 		return ErrReadTimeout
 	case errors.Is(err, mangos.ErrSendTimeout):
 		return ErrSendTimeout
+	case errors.Is(err, mangos.ErrNoPeers):
+		return ErrNoPeers
 	}
 
 	return fmt.Errorf("socket error: %w", err)
@@ -53,6 +58,15 @@ func WithReadTimeout(t time.Duration) func(OptionSetter) error {
 	}
 }
 
+// WithFailNoPeers makes send and receive fail with ErrNoPeers right away when no peer
+// is connected, instead of waiting for the timeout. A request in flight fails as soon
+// as the last peer disconnects.
+func WithFailNoPeers() func(OptionSetter) error {
+	return func(o OptionSetter) error {
+		return o.SetOption(mangos.OptionFailNoPeers, true)
+	}
+}
+
 type Options struct {
 	SendTimeout time.Duration
 	ReadTimeout time.Duration
@@ -67,6 +81,11 @@ type Base interface {
 type Requester interface {
 	Base
 	Request(msg proto.Message, reply proto.Message, opts ...func(OptionSetter) error) error
+	// Peers returns the number of connected peers.
+	Peers() int
+	// Replies returns when each connected peer last replied, peers that have not replied
+	// yet report when they connected.
+	Replies() map[uint32]time.Time
 }
 
 func NewRequester(host string, port int, dial bool, options ...func(OptionSetter) error) (Requester, error) {
@@ -75,38 +94,65 @@ func NewRequester(host string, port int, dial bool, options ...func(OptionSetter
 		return nil, fmt.Errorf("failed to create requester socket: %w", err)
 	}
 
-	if dial { //nolint: nestif
-		err = req.Dial(fmt.Sprintf("tcp://%s:%d", host, port))
-		if err != nil {
-			return nil, fmt.Errorf("failed to dial: %w", err)
-		}
-	} else {
-		if port == 0 {
-			port, err = ListenToFreePort(req, host)
-			if err != nil {
-				return nil, fmt.Errorf("failed to listen to free port: %w", err)
-			}
-		} else {
-			err = req.Listen(fmt.Sprintf("tcp://%s:%d", host, port))
-			if err != nil {
-				return nil, fmt.Errorf("failed to listen: %w", err)
-			}
+	r := &requester{socket: req, host: host, replies: make(map[uint32]time.Time)}
+	req.SetPipeEventHook(func(event mangos.PipeEvent, pipe mangos.Pipe) {
+		switch event {
+		case mangos.PipeEventAttached:
+			r.peers.Add(1)
+			r.replied(pipe.ID(), true)
+		case mangos.PipeEventDetached:
+			r.peers.Add(-1)
+			r.repliesLock.Lock()
+			delete(r.replies, pipe.ID())
+			r.repliesLock.Unlock()
+		case mangos.PipeEventAttaching:
 		}
-	}
+	})
 
-	for _, opt := range options {
-		if err := opt(req); err != nil {
-			return nil, fmt.Errorf("failed to set option: %w", err)
-		}
+	r.port, r.lease, err = bind(req, host, port, dial, options)
+	if err != nil {
+		return nil, err
 	}
 
-	return &requester{socket: req, host: host, port: port}, nil
+	return r, nil
 }
 
 type requester struct {
 	socket mangos.Socket
 	host   string
 	port   int
+	lease  *ports.Lease
+	peers  atomic.Int32
+
+	replies     map[uint32]time.Time
+	repliesLock sync.Mutex
+}
+
+func (r *requester) Peers() int {
+	return int(r.peers.Load())
+}
+
+// replied records a reply from the peer, a peer that is not attached is only added when
+// add is set so that a late reply does not bring back a detached peer.
+func (r *requester) replied(id uint32, add bool) {
+	r.repliesLock.Lock()
+	defer r.repliesLock.Unlock()
+
+	if _, attached := r.replies[id]; attached || add {
+		r.replies[id] = time.Now()
+	}
+}
+
+func (r *requester) Replies() map[uint32]time.Time {
+	r.repliesLock.Lock()
+	defer r.repliesLock.Unlock()
+
+	replies := make(map[uint32]time.Time, len(r.replies))
+	for id, at := range r.replies {
+		replies[id] = at
+	}
+
+	return replies
 }
 
 func (r *requester) GetHost() string {
@@ -118,6 +164,8 @@ func (r *requester) GetPort() int {
 }
 
 func (r *requester) Close() error {
+	r.lease.Release()
+
 	if err := r.socket.Close(); err != nil {
 		return sockError(err)
 	}
@@ -148,13 +196,19 @@ func (r *requester) Request(msg proto.Message, reply proto.Message, options ...f
 		return sockError(err)
 	}
 
-	bytes, err = ctx.Recv()
+	response, err := ctx.RecvMsg()
 	if err != nil {
 		return sockError(err)
 	}
 
+	defer response.Free()
+
+	if response.Pipe != nil {
+		r.replied(response.Pipe.ID(), false)
+	}
+
 	if reply != nil {
-		if err = proto.Unmarshal(bytes, reply); err != nil {
+		if err = proto.Unmarshal(response.Body, reply); err != nil {
 			return fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 	}
@@ -177,38 +231,19 @@ func NewReplier(host string, port int, dial bool, options ...func(OptionSetter)
 		return nil, fmt.Errorf("failed to create replier socket: %w", err)
 	}
 
-	if dial { //nolint: nestif
-		err = rep.Dial(fmt.Sprintf("tcp://%s:%d", host, port))
-		if err != nil {
-			return nil, fmt.Errorf("failed to dial: %w", err)
-		}
-	} else {
-		if port == 0 {
-			port, err = ListenToFreePort(rep, host)
-			if err != nil {
-				return nil, fmt.Errorf("failed to listen: %w", err)
-			}
-		} else {
-			err = rep.Listen(fmt.Sprintf("tcp://%s:%d", host, port))
-			if err != nil {
-				return nil, fmt.Errorf("failed to listen: %w", err)
-			}
-		}
-	}
-
-	for _, opt := range options {
-		if err := opt(rep); err != nil {
-			return nil, fmt.Errorf("failed to set option: %w", err)
-		}
+	port, lease, err := bind(rep, host, port, dial, options)
+	if err != nil {
+		return nil, err
 	}
 
-	return &replier{socket: rep, host: host, port: port}, nil
+	return &replier{socket: rep, host: host, port: port, lease: lease}, nil
 }
 
 type replier struct {
 	socket mangos.Socket
 	host   string
 	port   int
+	lease  *ports.Lease
 }
 
 func (r *replier) GetHost() string {
@@ -220,6 +255,8 @@ func (r *replier) GetPort() int {
 }
 
 func (r *replier) Close() error {
+	r.lease.Release()
+
 	if err := r.socket.Close(); err != nil {
 		return sockError(err)
 	}
@@ -289,23 +326,18 @@ func NewSubscriber(host string, port int, options ...func(OptionSetter) error) (
 		return nil, fmt.Errorf("failed to create subscriber socket: %w", err)
 	}
 
-	err = sub.Dial(fmt.Sprintf("tcp://%s:%d", host, port))
-	if err != nil {
-		return nil, fmt.Errorf("failed to dial: %w", err)
-	}
-
 	err = sub.SetOption(mangos.OptionSubscribe, []byte(""))
 	if err != nil {
+		sub.Close()
 		return nil, fmt.Errorf("failed to subscribe: %w", err)
 	}
 
-	for _, opt := range options {
-		if err := opt(sub); err != nil {
-			return nil, fmt.Errorf("failed to set option: %w", err)
-		}
+	_, _, err = bind(sub, host, port, true, options)
+	if err != nil {
+		return nil, err
 	}
 
-	return &subscriber{socket: sub}, nil
+	return &subscriber{socket: sub, host: host, port: port}, nil
 }
 
 type subscriber struct {
@@ -343,23 +375,165 @@ func (s *subscriber) Receive(msg proto.Message, _ ...func(OptionSetter) error) e
 	return nil
 }
 
-func ListenToFreePort(socket mangos.Socket, host string) (int, error) {
-	var err error
+// Publisher broadcasts messages to all connected subscribers. Publishing never waits
+// for subscribers, messages are dropped for subscribers that can not keep up.
+type Publisher interface {
+	Base
+	Publish(msg proto.Message) error
+}
+
+func NewPublisher(host string, port int, options ...func(OptionSetter) error) (Publisher, error) {
+	pub, err := pub.NewSocket()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create publisher socket: %w", err)
+	}
+
+	port, lease, err := bind(pub, host, port, false, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &publisher{socket: pub, host: host, port: port, lease: lease}, nil
+}
 
-	for port := environment.GetBacktestPortRangeStart(); port <= environment.GetBacktestPortRangeEnd(); port++ {
-		err = socket.Listen(fmt.Sprintf("tcp://%v:%v", host, port))
+type publisher struct {
+	socket mangos.Socket
+	host   string
+	port   int
+	lease  *ports.Lease
+}
 
-		if err == nil {
-			return port, nil
+func (p *publisher) GetHost() string {
+	return p.host
+}
+
+func (p *publisher) GetPort() int {
+	return p.port
+}
+
+func (p *publisher) Close() error {
+	p.lease.Release()
+
+	if err := p.socket.Close(); err != nil {
+		return sockError(err)
+	}
+
+	return nil
+}
+
+func (p *publisher) Publish(msg proto.Message) error {
+	bytes, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	if err := p.socket.Send(bytes); err != nil {
+		return sockError(err)
+	}
+
+	return nil
+}
+
+// socketOptions applies options to a socket, the TLS configuration is held back as
+// mangos only takes it when listening or dialing.
+type socketOptions struct {
+	socket mangos.Socket
+	tls    *tls.Config
+	owner  ports.Owner
+}
+
+func (o *socketOptions) SetOption(name string, value interface{}) error {
+	switch name {
+	case mangos.OptionTLSConfig:
+		config, isConfig := value.(*tls.Config)
+		if !isConfig {
+			return fmt.Errorf("invalid tls config %T", value)
 		}
 
-		if strings.Compare(errors.Unwrap(err).Error(), "bind: address already in use") == 0 {
-			log.Debug().Msgf("Port %v already in use, trying next port", port)
-			continue
+		o.tls = config
+	case optionPortOwner:
+		owner, isOwner := value.(ports.Owner)
+		if !isOwner {
+			return fmt.Errorf("invalid port owner %T", value)
 		}
 
-		return 0, fmt.Errorf("error listening to port %v: %w", port, err)
+		o.owner = owner
+	default:
+		return o.socket.SetOption(name, value)
+	}
+
+	return nil
+}
+
+func (o *socketOptions) address(host string, port int) string {
+	if o.tls != nil {
+		return fmt.Sprintf("tls+tcp://%s:%d", host, port)
+	}
+
+	return fmt.Sprintf("tcp://%s:%d", host, port)
+}
+
+func (o *socketOptions) transportOptions() map[string]interface{} {
+	if o.tls == nil {
+		return nil
+	}
+
+	return map[string]interface{}{mangos.OptionTLSConfig: o.tls}
+}
+
+// WithTLS makes the socket listen or dial with TLS, a listener given a config that
+// requires client certificates rejects peers without a valid certificate.
+func WithTLS(config *tls.Config) func(OptionSetter) error {
+	return func(o OptionSetter) error {
+		return o.SetOption(mangos.OptionTLSConfig, config)
+	}
+}
+
+const optionPortOwner = "FOREVERBULL-PORT-OWNER"
+
+// WithOwner sets the owner of the port lease taken by a socket listening on port 0.
+func WithOwner(owner ports.Owner) func(OptionSetter) error {
+	return func(o OptionSetter) error {
+		return o.SetOption(optionPortOwner, owner)
+	}
+}
+
+// bind applies the options and then dials or listens, a listener on port 0 leases a
+// port from the backtest port range. The lease is released when the socket is closed,
+// the socket is closed when it can't be bound.
+func bind(socket mangos.Socket, host string, port int, dial bool, options []func(OptionSetter) error,
+) (int, *ports.Lease, error) {
+	opts := &socketOptions{socket: socket}
+
+	for _, opt := range options {
+		if err := opt(opts); err != nil {
+			socket.Close()
+			return 0, nil, fmt.Errorf("failed to set option: %w", err)
+		}
+	}
+
+	switch {
+	case dial:
+		if err := socket.DialOptions(opts.address(host, port), opts.transportOptions()); err != nil {
+			socket.Close()
+			return 0, nil, fmt.Errorf("failed to dial: %w", err)
+		}
+	case port == 0:
+		lease, err := ports.Default().Acquire(opts.owner, 0, func(port int) error {
+			return socket.ListenOptions(opts.address(host, port), opts.transportOptions())
+		})
+		if err != nil {
+			socket.Close()
+			return 0, nil, fmt.Errorf("failed to listen to free port: %w", err)
+		}
+
+		return lease.Port, lease, nil
+	default:
+		if err := socket.ListenOptions(opts.address(host, port), opts.transportOptions()); err != nil {
+			socket.Close()
+			return 0, nil, fmt.Errorf("failed to listen: %w", err)
+		}
 	}
 
-	return 0, errors.New("no free ports in range")
+	return port, nil, nil
 }
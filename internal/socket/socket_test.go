@@ -1,11 +1,15 @@
 package socket
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/lhjnilsson/foreverbull/internal/ports"
 	"github.com/lhjnilsson/foreverbull/internal/test_helper"
 	common_pb "github.com/lhjnilsson/foreverbull/pkg/pb"
 	"github.com/stretchr/testify/suite"
+	"go.nanomsg.org/mangos/v3"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -61,10 +65,18 @@ func (test *SocketTest) TestRequesterReplier() {
 
 			request.Data = data
 			response := common_pb.Response{}
+			sent := time.Now()
 			err = requester.Request(&request, &response)
 			test.Require().NoError(err, "failed to request")
 			test.Equal(request.Task, response.Task, "task mismatch")
 			test.Equal(request.Data, response.Data, "data mismatch")
+
+			replies := requester.Replies()
+			test.Require().Len(replies, 1)
+
+			for _, replied := range replies {
+				test.False(replied.Before(sent), "reply not recorded")
+			}
 		})
 	}
 
@@ -72,7 +84,7 @@ func (test *SocketTest) TestRequesterReplier() {
 	test.NoError(requester.Close(), "failed to close requester")
 }
 
-func (test *SocketTest) TestListenToFreePort() {
+func (test *SocketTest) TestFreePort() {
 	test.Run("requester", func() {
 		requester, err := NewRequester("0.0.0.0", 0, false)
 		test.Require().NoError(err, "failed to create requester")
@@ -86,3 +98,208 @@ func (test *SocketTest) TestListenToFreePort() {
 		test.NoError(replier.Close(), "failed to close replier")
 	})
 }
+
+func (test *SocketTest) TestPublisherFreePort() {
+	publisher, err := NewPublisher("0.0.0.0", 0)
+	test.Require().NoError(err, "failed to create publisher")
+	test.NotEqual(0, publisher.GetPort(), "port is 0")
+	test.NoError(publisher.Close(), "failed to close publisher")
+}
+
+func (test *SocketTest) TestPortOwner() {
+	owner := ports.Owner{Kind: ports.Session, ID: "socket-test"}
+	leased := func(port int) bool {
+		for _, lease := range ports.Default().Leases() {
+			if lease.Port == port && lease.Owner == owner {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	replier, err := NewReplier("0.0.0.0", 0, false, WithOwner(owner))
+	test.Require().NoError(err, "failed to create replier")
+	test.True(leased(replier.GetPort()), "port is not leased")
+	test.NoError(replier.Close(), "failed to close replier")
+	test.False(leased(replier.GetPort()), "port is still leased")
+}
+
+func (test *SocketTest) TestBindError() {
+	replier, err := NewReplier("0.0.0.0", 0, false)
+	test.Require().NoError(err, "failed to create replier")
+
+	defer replier.Close()
+
+	type testCase struct {
+		name   string
+		create func(option func(OptionSetter) error) error
+	}
+
+	testCases := []testCase{
+		{
+			name: "option",
+			create: func(option func(OptionSetter) error) error {
+				_, err := NewRequester("127.0.0.1", replier.GetPort(), true, option, func(OptionSetter) error {
+					return errors.New("bad option")
+				})
+				return err
+			},
+		},
+		{
+			name: "dial",
+			create: func(option func(OptionSetter) error) error {
+				_, err := NewRequester("127.0.0.1", 1, true, option)
+				return err
+			},
+		},
+		{
+			name: "listen",
+			create: func(option func(OptionSetter) error) error {
+				_, err := NewPublisher("0.0.0.0", replier.GetPort(), option)
+				return err
+			},
+		},
+		{
+			name: "free port",
+			create: func(option func(OptionSetter) error) error {
+				_, err := NewReplier("256.0.0.1", 0, false, option)
+				return err
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test.Run(testCase.name, func() {
+			var opts *socketOptions
+
+			err := testCase.create(func(o OptionSetter) error {
+				opts, _ = o.(*socketOptions)
+				return nil
+			})
+			test.Require().Error(err)
+			test.Require().NotNil(opts)
+			test.ErrorIs(opts.socket.Listen("tcp://127.0.0.1:0"), mangos.ErrClosed, "socket is not closed")
+		})
+	}
+}
+
+func (test *SocketTest) TestPublisherSubscriber() {
+	publisher, err := NewPublisher("0.0.0.0", 0)
+	test.Require().NoError(err, "failed to create publisher")
+
+	// Publishing without subscribers must not block or fail
+	test.Require().NoError(publisher.Publish(&common_pb.Request{Task: "dropped"}))
+
+	subscribers := make([]Subscriber, 0, 2)
+
+	for range 2 {
+		subscriber, err := NewSubscriber("127.0.0.1", publisher.GetPort(), WithReadTimeout(time.Second))
+		test.Require().NoError(err, "failed to create subscriber")
+
+		subscribers = append(subscribers, subscriber)
+	}
+
+	// Subscribers only get messages published after they are connected
+	received := make(chan string, 2)
+
+	for _, subscriber := range subscribers {
+		go func() {
+			for {
+				msg := common_pb.Request{}
+				if err := subscriber.Receive(&msg); err != nil {
+					return
+				}
+
+				if msg.Task == "test" {
+					received <- msg.Task
+					return
+				}
+			}
+		}()
+	}
+
+	test.Eventually(func() bool {
+		test.NoError(publisher.Publish(&common_pb.Request{Task: "test"}))
+		return len(received) == 2
+	}, time.Second, time.Millisecond*10)
+
+	for _, subscriber := range subscribers {
+		test.NoError(subscriber.Close(), "failed to close subscriber")
+	}
+
+	test.NoError(publisher.Close(), "failed to close publisher")
+}
+
+func (test *SocketTest) TestTLS() {
+	credentials, err := NewCredentials("foreverbull", time.Hour)
+	test.Require().NoError(err)
+
+	serverTLS, err := credentials.ServerTLS()
+	test.Require().NoError(err)
+
+	clientTLS, err := credentials.ClientTLS()
+	test.Require().NoError(err)
+
+	replier, err := NewReplier("0.0.0.0", 0, false, WithTLS(serverTLS))
+	test.Require().NoError(err, "failed to create replier")
+
+	defer replier.Close()
+
+	go func() {
+		for {
+			request := common_pb.Request{}
+
+			sock, err := replier.Receive(&request)
+			if err != nil {
+				return
+			}
+
+			test.NoError(sock.Reply(&common_pb.Response{Task: request.Task}))
+		}
+	}()
+
+	test.Run("valid credentials", func() {
+		requester, err := NewRequester("127.0.0.1", replier.GetPort(), true, WithTLS(clientTLS))
+		test.Require().NoError(err, "failed to create requester")
+
+		defer requester.Close()
+
+		response := common_pb.Response{}
+		test.Require().NoError(requester.Request(&common_pb.Request{Task: "test"}, &response,
+			WithReadTimeout(time.Second), WithSendTimeout(time.Second)))
+		test.Equal("test", response.Task)
+	})
+
+	other, err := NewCredentials("foreverbull", time.Hour)
+	test.Require().NoError(err)
+
+	otherTLS, err := other.ClientTLS()
+	test.Require().NoError(err)
+
+	withoutCertificate := clientTLS.Clone()
+	withoutCertificate.Certificates = nil
+
+	// Rejected peers never finish the handshake, so they have to dial asynchronously
+	dialAsync := func(o OptionSetter) error {
+		return o.SetOption(mangos.OptionDialAsynch, true)
+	}
+
+	for name, options := range map[string][]func(OptionSetter) error{
+		"plain tcp":           {dialAsync},
+		"other credentials":   {dialAsync, WithTLS(otherTLS)},
+		"without certificate": {dialAsync, WithTLS(withoutCertificate)},
+	} {
+		test.Run(name, func() {
+			requester, err := NewRequester("127.0.0.1", replier.GetPort(), true, options...)
+			test.Require().NoError(err, "failed to create requester")
+
+			defer requester.Close()
+
+			err = requester.Request(&common_pb.Request{Task: "test"}, &common_pb.Response{},
+				WithReadTimeout(time.Second/4), WithSendTimeout(time.Second/4))
+			test.Error(err)
+			test.Zero(requester.Peers())
+		})
+	}
+}
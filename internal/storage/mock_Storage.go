@@ -111,6 +111,61 @@ func (_m *MockStorage) ListObjects(ctx context.Context, bucket Bucket) (*[]Objec
 	return r0, r1
 }
 
+// PutObject provides a mock function with given fields: ctx, bucket, name, data, opts
+func (_m *MockStorage) PutObject(ctx context.Context, bucket Bucket, name string, data []byte, opts ...func(*minio.PutObjectOptions) error) (*Object, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, bucket, name, data)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutObject")
+	}
+
+	var r0 *Object
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Bucket, string, []byte, ...func(*minio.PutObjectOptions) error) (*Object, error)); ok {
+		return rf(ctx, bucket, name, data, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Bucket, string, []byte, ...func(*minio.PutObjectOptions) error) *Object); ok {
+		r0 = rf(ctx, bucket, name, data, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Object)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Bucket, string, []byte, ...func(*minio.PutObjectOptions) error) error); ok {
+		r1 = rf(ctx, bucket, name, data, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveObject provides a mock function with given fields: ctx, bucket, name
+func (_m *MockStorage) RemoveObject(ctx context.Context, bucket Bucket, name string) error {
+	ret := _m.Called(ctx, bucket, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveObject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, Bucket, string) error); ok {
+		r0 = rf(ctx, bucket, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMockStorage creates a new instance of MockStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStorage(t interface {
@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -35,11 +37,16 @@ const (
 	IngestionsBucket Bucket = "ingestions"
 )
 
+var ErrObjectNotFound = errors.New("object not found")
+
 type Storage interface {
 	ListObjects(ctx context.Context, bucket Bucket) (*[]Object, error)
 	GetObject(ctx context.Context, bucket Bucket, name string) (*Object, error)
 	CreateObject(ctx context.Context, bucket Bucket, name string,
 		opts ...func(*minio.PutObjectOptions) error) (*Object, error)
+	PutObject(ctx context.Context, bucket Bucket, name string, data []byte,
+		opts ...func(*minio.PutObjectOptions) error) (*Object, error)
+	RemoveObject(ctx context.Context, bucket Bucket, name string) error
 }
 
 type Object struct {
@@ -162,6 +169,10 @@ func (s *MinioStorage) ListObjects(ctx context.Context, bucket Bucket) (*[]Objec
 func (s *MinioStorage) GetObject(ctx context.Context, bucket Bucket, name string) (*Object, error) {
 	object, err := s.client.StatObject(ctx, string(bucket), name, minio.GetObjectOptions{})
 	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("error getting object: %w", ErrObjectNotFound)
+		}
+
 		return nil, fmt.Errorf("error getting object: %w", err)
 	}
 
@@ -196,3 +207,33 @@ func (s *MinioStorage) CreateObject(ctx context.Context, bucket Bucket, name str
 
 	return s.GetObject(ctx, bucket, name)
 }
+
+// PutObject creates or replaces the object with the given content.
+func (s *MinioStorage) PutObject(ctx context.Context, bucket Bucket, name string, data []byte,
+	opts ...func(*minio.PutObjectOptions) error,
+) (*Object, error) {
+	putOptions := minio.PutObjectOptions{}
+
+	for _, opt := range opts {
+		if err := opt(&putOptions); err != nil {
+			return nil, fmt.Errorf("error applying option: %w", err)
+		}
+	}
+
+	_, err := s.client.PutObject(ctx, string(bucket), name, bytes.NewReader(data), int64(len(data)), putOptions)
+	if err != nil {
+		return nil, fmt.Errorf("error putting object: %w", err)
+	}
+
+	return s.GetObject(ctx, bucket, name)
+}
+
+// RemoveObject removes the object, removing an object that does not exist is not an error.
+func (s *MinioStorage) RemoveObject(ctx context.Context, bucket Bucket, name string) error {
+	err := s.client.RemoveObject(ctx, string(bucket), name, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("error removing object: %w", err)
+	}
+
+	return nil
+}
@@ -101,6 +101,21 @@ func (test *StorageTest) TestStorage() {
 		test.Require().Len(*objects, 1)
 		test.Require().Equal(metadata, (*objects)[0].Metadata)
 	})
+	test.Run("Put Object", func() {
+		object, err := test.storage.PutObject(context.Background(), ResultsBucket, "archive.json", []byte(`{"key": "value"}`))
+		test.Require().NoError(err)
+		test.Equal(int64(16), object.Size)
+	})
+	test.Run("Remove Object", func() {
+		_, err := test.storage.CreateObject(context.Background(), ResultsBucket, "remove.pickle")
+		test.Require().NoError(err)
+
+		test.Require().NoError(test.storage.RemoveObject(context.Background(), ResultsBucket, "remove.pickle"))
+		test.Require().NoError(test.storage.RemoveObject(context.Background(), ResultsBucket, "remove.pickle"))
+
+		_, err = test.storage.GetObject(context.Background(), ResultsBucket, "remove.pickle")
+		test.ErrorIs(err, ErrObjectNotFound)
+	})
 }
 
 func (test *StorageTest) TestObject() {
@@ -0,0 +1,303 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/container"
+	"github.com/lhjnilsson/foreverbull/internal/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	DB              = NewSingleton[*pgxpool.Pool](DBDep)                 //nolint: gochecknoglobals
+	Storage         = NewSingleton[storage.Storage](StorageDep)          //nolint: gochecknoglobals
+	ContainerEngine = NewSingleton[container.Engine](ContainerEngineDep) //nolint: gochecknoglobals
+)
+
+// DependencyRequirement is a dependency a command needs, it is verified against the
+// dependency container when the command is registered.
+type DependencyRequirement interface {
+	Key() Dependency
+	check(dependencies DependencyContainer) error
+}
+
+type Singleton[T any] struct {
+	key Dependency
+}
+
+func NewSingleton[T any](key Dependency) Singleton[T] {
+	return Singleton[T]{key: key}
+}
+
+func (s Singleton[T]) Key() Dependency {
+	return s.key
+}
+
+func (s Singleton[T]) Get(msg Message) (T, error) {
+	v, ok := msg.MustGet(s.key).(T)
+	if !ok {
+		return v, fmt.Errorf("dependency %s is not of type %T", s.key, v)
+	}
+
+	return v, nil
+}
+
+func (s Singleton[T]) check(dependencies DependencyContainer) error {
+	v, exists := dependencies.GetSingleton(s.key)
+	if !exists {
+		return fmt.Errorf("dependency not found: %s", s.key)
+	}
+
+	if _, ok := v.(T); !ok {
+		var expected T
+		return fmt.Errorf("dependency %s is %T, expected %T", s.key, v, expected)
+	}
+
+	return nil
+}
+
+// Method is a dependency created per message, it is added to the dependency container
+// with Provide so that its type can be verified when a command is registered.
+type Method[T any] struct {
+	key Dependency
+}
+
+func NewMethod[T any](key Dependency) Method[T] {
+	return Method[T]{key: key}
+}
+
+func (m Method[T]) Key() Dependency {
+	return m.key
+}
+
+// Provide adds f as the method to the dependency container.
+func (m Method[T]) Provide(dependencies DependencyContainer, f func(context.Context, Message) (T, error)) {
+	dependencies.AddMethod(m.key, func(ctx context.Context, msg Message) (interface{}, error) {
+		return f(ctx, msg)
+	})
+
+	if dc, isContainer := dependencies.(*dependencyContainer); isContainer {
+		dc.methodTypes[m.key] = reflect.TypeFor[T]()
+	}
+}
+
+func (m Method[T]) Call(ctx context.Context, msg Message) (T, error) {
+	var v T
+
+	dep, err := msg.Call(ctx, m.key)
+	if err != nil {
+		return v, fmt.Errorf("error calling dependency %s: %w", m.key, err)
+	}
+
+	v, ok := dep.(T)
+	if !ok {
+		return v, fmt.Errorf("dependency %s is not of type %T", m.key, v)
+	}
+
+	return v, nil
+}
+
+func (m Method[T]) check(dependencies DependencyContainer) error {
+	if !dependencies.HasMethod(m.key) {
+		return fmt.Errorf("dependency not found: %s", m.key)
+	}
+
+	dc, isContainer := dependencies.(*dependencyContainer)
+	if !isContainer {
+		return nil
+	}
+
+	provided, typed := dc.methodTypes[m.key]
+	if !typed {
+		return fmt.Errorf("dependency %s is not typed, it must be added with Provide", m.key)
+	}
+
+	if expected := reflect.TypeFor[T](); !provided.AssignableTo(expected) {
+		return fmt.Errorf("dependency %s is %s, expected %s", m.key, provided, expected)
+	}
+
+	return nil
+}
+
+// PayloadUpgrade converts a raw payload from one schema version to the next.
+type PayloadUpgrade func(payload []byte) ([]byte, error)
+
+var (
+	errNewerSchemaVersion = errors.New("schema version is newer than supported")
+	errMissingUpgrade     = errors.New("no upgrade for schema version")
+)
+
+// Command declares the subject, payload and dependencies of a command. It is used
+// both to create messages and to register the handler of the command.
+type Command[T any] struct {
+	Module       string
+	Component    string
+	Method       string
+	Dependencies []DependencyRequirement
+
+	version  int
+	upgrades []PayloadUpgrade
+}
+
+func NewCommand[T any](module, component, method string, dependencies ...DependencyRequirement) Command[T] {
+	return Command[T]{
+		Module:       module,
+		Component:    component,
+		Method:       method,
+		Dependencies: dependencies,
+		version:      defaultSchemaVersion,
+	}
+}
+
+// WithUpgrade bumps the schema version of the command, messages of the previous version
+// are converted with upgrade before they are parsed. Upgrades are applied in the order
+// they are added so messages of any older version can be handled.
+func (c Command[T]) WithUpgrade(upgrade PayloadUpgrade) Command[T] {
+	c.upgrades = append(append([]PayloadUpgrade{}, c.upgrades...), upgrade)
+	c.version++
+
+	return c
+}
+
+// Version is the schema version of the payload created by the command.
+func (c Command[T]) Version() int {
+	return c.version
+}
+
+func (c Command[T]) Subject() string {
+	return fmt.Sprintf("foreverbull.%s.%s.%s.command", c.Module, c.Component, c.Method)
+}
+
+func (c Command[T]) NewMessage(payload T) (Message, error) {
+	msg, err := NewMessage(c.Module, c.Component, c.Method, payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s message: %w", c.Subject(), err)
+	}
+
+	msg.(*message).SchemaVersion = c.version
+
+	return msg, nil
+}
+
+// Parse upgrades the payload to the version of the command before it is unmarshalled.
+func (c Command[T]) Parse(msg Message) (T, error) {
+	var payload T
+
+	raw := msg.RawPayload()
+	version := max(msg.GetSchemaVersion(), defaultSchemaVersion)
+
+	if version > c.version {
+		return payload, fmt.Errorf("error parsing %s payload of version %d: %w", c.Subject(), version, errNewerSchemaVersion)
+	}
+
+	for ; version < c.version; version++ {
+		upgrade := version - defaultSchemaVersion
+		if upgrade >= len(c.upgrades) {
+			return payload, fmt.Errorf("error upgrading %s payload from version %d: %w", c.Subject(), version, errMissingUpgrade)
+		}
+
+		var err error
+
+		raw, err = c.upgrades[upgrade](raw)
+		if err != nil {
+			return payload, fmt.Errorf("error upgrading %s payload from version %d: %w", c.Subject(), version, err)
+		}
+	}
+
+	var target interface{} = &payload
+
+	if pbMsg, isProto := any(payload).(proto.Message); isProto {
+		payload, _ = pbMsg.ProtoReflect().Type().New().Interface().(T)
+		target = payload
+	}
+
+	err := unmarshalPayload(raw, target)
+	if err != nil {
+		return payload, fmt.Errorf("error parsing %s payload: %w", c.Subject(), err)
+	}
+
+	return payload, nil
+}
+
+func (c Command[T]) validate(dependencies DependencyContainer) error {
+	var payload T
+
+	if _, isProto := any(payload).(proto.Message); !isProto {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("payload %T can not be marshalled: %w", payload, err)
+		}
+
+		if err = json.Unmarshal(b, &payload); err != nil {
+			return fmt.Errorf("payload %T can not be unmarshalled: %w", payload, err)
+		}
+	}
+
+	for _, dependency := range c.Dependencies {
+		if err := dependency.check(dependencies); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type CommandHandler[T any] func(ctx context.Context, msg Message, payload T) error
+
+// Registry subscribes typed command handlers on the stream of a module.
+type Registry struct {
+	module       string
+	stream       Stream
+	dependencies DependencyContainer
+	subjects     map[string]bool
+}
+
+func NewRegistry(module string, stream Stream, dependencies DependencyContainer) *Registry {
+	return &Registry{
+		module:       module,
+		stream:       stream,
+		dependencies: dependencies,
+		subjects:     make(map[string]bool),
+	}
+}
+
+// Register validates the command against the registry and subscribes the handler, the
+// payload is parsed before the handler is called and a malformed payload is not retried.
+// Neither is a payload of a newer schema version, it is dead lettered and can be redriven
+// once every replica runs the newer version.
+func Register[T any](registry *Registry, cmd Command[T], handler CommandHandler[T], options ...SubscriberOption) error {
+	if cmd.Module != registry.module {
+		return fmt.Errorf("command %s does not belong to module %s", cmd.Subject(), registry.module)
+	}
+
+	if registry.subjects[cmd.Subject()] {
+		return fmt.Errorf("command %s is already registered", cmd.Subject())
+	}
+
+	if err := cmd.validate(registry.dependencies); err != nil {
+		return fmt.Errorf("error validating command %s: %w", cmd.Subject(), err)
+	}
+
+	cb := func(ctx context.Context, msg Message) error {
+		payload, err := cmd.Parse(msg)
+		if err != nil {
+			return NonRetryable(err)
+		}
+
+		return handler(ctx, msg, payload)
+	}
+
+	err := registry.stream.CommandSubscriber(cmd.Component, cmd.Method, cb, options...)
+	if err != nil {
+		return fmt.Errorf("error subscribing to %s: %w", cmd.Subject(), err)
+	}
+
+	registry.subjects[cmd.Subject()] = true
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/lhjnilsson/foreverbull/pkg/pb/stream"
+	"github.com/stretchr/testify/suite"
+)
+
+type CommandTest struct {
+	suite.Suite
+
+	bus          *MemoryBus
+	stream       Stream
+	dependencies DependencyContainer
+	registry     *Registry
+}
+
+func TestCommand(t *testing.T) {
+	suite.Run(t, new(CommandTest))
+}
+
+var (
+	testName   = NewSingleton[string]("name")
+	testNumber = NewMethod[int]("number")
+)
+
+func (test *CommandTest) SetupTest() {
+	test.bus = NewMemoryBus()
+	test.dependencies = NewDependencyContainer()
+	test.dependencies.AddSingleton("name", "test")
+	testNumber.Provide(test.dependencies, func(ctx context.Context, msg Message) (int, error) {
+		return 5, nil
+	})
+	test.stream = NewMemoryStream(test.bus, "test", test.dependencies)
+	test.registry = NewRegistry("test", test.stream, test.dependencies)
+}
+
+func (test *CommandTest) TearDownTest() {
+	test.NoError(test.stream.Unsubscribe())
+}
+
+func (test *CommandTest) TestNewMessage() {
+	cmd := NewCommand[TestPayload]("test", "payload", "parse")
+	test.Equal("foreverbull.test.payload.parse.command", cmd.Subject())
+
+	msg, err := cmd.NewMessage(TestPayload{Name: "test", Number: 5})
+	test.Require().NoError(err)
+	test.Equal("test", msg.(*message).Module)
+	test.Equal("payload", msg.(*message).Component)
+	test.Equal("parse", msg.(*message).Method)
+
+	payload, err := cmd.Parse(msg)
+	test.Require().NoError(err)
+	test.Equal(TestPayload{Name: "test", Number: 5}, payload)
+}
+
+func (test *CommandTest) TestRegisterValidation() {
+	handler := func(ctx context.Context, msg Message, payload TestPayload) error { return nil }
+
+	test.Run("other module", func() {
+		cmd := NewCommand[TestPayload]("other", "payload", "parse")
+		test.ErrorContains(Register(test.registry, cmd, handler), "does not belong to module test")
+	})
+	test.Run("missing singleton", func() {
+		cmd := NewCommand[TestPayload]("test", "payload", "singleton", NewSingleton[string]("unknown"))
+		test.ErrorContains(Register(test.registry, cmd, handler), "dependency not found: unknown")
+	})
+	test.Run("singleton of wrong type", func() {
+		cmd := NewCommand[TestPayload]("test", "payload", "type", NewSingleton[int]("name"))
+		test.ErrorContains(Register(test.registry, cmd, handler), "dependency name is string, expected int")
+	})
+	test.Run("missing method", func() {
+		cmd := NewCommand[TestPayload]("test", "payload", "method", NewMethod[int]("unknown"))
+		test.ErrorContains(Register(test.registry, cmd, handler), "dependency not found: unknown")
+	})
+	test.Run("method of wrong type", func() {
+		cmd := NewCommand[TestPayload]("test", "payload", "method", NewMethod[string]("number"))
+		test.ErrorContains(Register(test.registry, cmd, handler), "dependency number is int, expected string")
+	})
+	test.Run("untyped method", func() {
+		test.dependencies.AddMethod("untyped", func(ctx context.Context, msg Message) (interface{}, error) {
+			return 5, nil
+		})
+		cmd := NewCommand[TestPayload]("test", "payload", "method", NewMethod[int]("untyped"))
+		test.ErrorContains(Register(test.registry, cmd, handler), "dependency untyped is not typed")
+	})
+	test.Run("unsupported payload", func() {
+		cmd := NewCommand[chan int]("test", "payload", "chan")
+		err := Register(test.registry, cmd, func(ctx context.Context, msg Message, payload chan int) error { return nil })
+		test.ErrorContains(err, "can not be marshalled")
+	})
+	test.Run("registered twice", func() {
+		cmd := NewCommand[TestPayload]("test", "payload", "twice", testName, testNumber)
+		test.Require().NoError(Register(test.registry, cmd, handler))
+		test.ErrorContains(Register(test.registry, cmd, handler), "already registered")
+	})
+}
+
+func (test *CommandTest) TestRegister() {
+	type result struct {
+		payload TestPayload
+		name    string
+		number  int
+	}
+
+	results := make(chan result, 1)
+	cmd := NewCommand[TestPayload]("test", "payload", "handle", testName, testNumber)
+	err := Register(test.registry, cmd, func(ctx context.Context, msg Message, payload TestPayload) error {
+		name, err := testName.Get(msg)
+		if err != nil {
+			return err
+		}
+		number, err := testNumber.Call(ctx, msg)
+		if err != nil {
+			return err
+		}
+		results <- result{payload: payload, name: name, number: number}
+		return nil
+	})
+	test.Require().NoError(err)
+
+	msg, err := cmd.NewMessage(TestPayload{Name: "payload", Number: 1})
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+	select {
+	case r := <-results:
+		test.Equal(TestPayload{Name: "payload", Number: 1}, r.payload)
+		test.Equal("test", r.name)
+		test.Equal(5, r.number)
+	case <-time.After(time.Second):
+		test.Fail("command not handled")
+	}
+}
+
+func (test *CommandTest) TestRegisterMalformedPayload() {
+	var calls atomic.Int32
+
+	cmd := NewCommand[TestPayload]("test", "payload", "malformed")
+	err := Register(test.registry, cmd, func(ctx context.Context, msg Message, payload TestPayload) error {
+		calls.Add(1)
+		return errors.New("should not be called")
+	}, WithRetryPolicy(ExponentialRetryPolicy(3, time.Millisecond, time.Millisecond)))
+	test.Require().NoError(err)
+
+	msg, err := NewMessage("test", "payload", "malformed", "not a payload")
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+	test.Eventually(func() bool {
+		m, err := test.bus.getMessage(msg.GetID())
+		test.Require().NoError(err)
+
+		return m.StatusHistory[0].Status == MessageStatusError
+	}, time.Second, time.Millisecond*10)
+	test.Equal(int32(0), calls.Load())
+	m, err := test.bus.getMessage(msg.GetID())
+	test.Require().NoError(err)
+	test.Equal(1, m.Attempts)
+}
+
+func (test *CommandTest) TestRegisterNewerSchemaVersion() {
+	var calls atomic.Int32
+
+	cmd := NewCommand[TestPayload]("test", "payload", "newer")
+	err := Register(test.registry, cmd, func(ctx context.Context, msg Message, payload TestPayload) error {
+		calls.Add(1)
+		return nil
+	}, WithRetryPolicy(ExponentialRetryPolicy(3, time.Millisecond, time.Millisecond)))
+	test.Require().NoError(err)
+
+	msg, err := cmd.WithUpgrade(func(payload []byte) ([]byte, error) {
+		return payload, nil
+	}).NewMessage(TestPayload{Name: "newer"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+	test.Eventually(func() bool {
+		m, err := test.bus.getMessage(msg.GetID())
+		test.Require().NoError(err)
+
+		return m.StatusHistory[0].Status == MessageStatusError
+	}, time.Second, time.Millisecond*10)
+	test.Equal(int32(0), calls.Load())
+	m, err := test.bus.getMessage(msg.GetID())
+	test.Require().NoError(err)
+	test.Equal(1, m.Attempts)
+	test.Contains(*m.Error, errNewerSchemaVersion.Error())
+}
+
+func (test *CommandTest) TestProtoPayload() {
+	received := make(chan *pb.Command, 1)
+	cmd := NewCommand[*pb.Command]("test", "payload", "proto")
+	err := Register(test.registry, cmd, func(ctx context.Context, msg Message, payload *pb.Command) error {
+		received <- payload
+		return nil
+	})
+	test.Require().NoError(err)
+
+	msg, err := cmd.NewMessage(&pb.Command{Module: "test", Attempts: 2})
+	test.Require().NoError(err)
+	test.JSONEq(`{"module": "test", "attempts": 2}`, string(msg.RawPayload()))
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+	select {
+	case payload := <-received:
+		test.Equal("test", payload.GetModule())
+		test.Equal(int32(2), payload.GetAttempts())
+	case <-time.After(time.Second):
+		test.Fail("command not handled")
+	}
+}
+
+func (test *CommandTest) TestUpgrade() {
+	type v1 struct {
+		Name string
+	}
+
+	cmdV1 := NewCommand[v1]("test", "payload", "upgrade")
+	cmd := NewCommand[TestPayload]("test", "payload", "upgrade").WithUpgrade(func(payload []byte) ([]byte, error) {
+		old := v1{}
+		if err := json.Unmarshal(payload, &old); err != nil {
+			return nil, err
+		}
+		return json.Marshal(TestPayload{Name: old.Name, Number: 1})
+	})
+	test.Equal(1, cmdV1.Version())
+	test.Equal(2, cmd.Version())
+
+	test.Run("older version", func() {
+		msg, err := cmdV1.NewMessage(v1{Name: "old"})
+		test.Require().NoError(err)
+		test.Equal(1, msg.GetSchemaVersion())
+
+		payload, err := cmd.Parse(msg)
+		test.Require().NoError(err)
+		test.Equal(TestPayload{Name: "old", Number: 1}, payload)
+	})
+	test.Run("current version", func() {
+		msg, err := cmd.NewMessage(TestPayload{Name: "new", Number: 5})
+		test.Require().NoError(err)
+		test.Equal(2, msg.GetSchemaVersion())
+
+		payload, err := cmd.Parse(msg)
+		test.Require().NoError(err)
+		test.Equal(TestPayload{Name: "new", Number: 5}, payload)
+	})
+	test.Run("newer version", func() {
+		msg, err := cmd.NewMessage(TestPayload{Name: "new", Number: 5})
+		test.Require().NoError(err)
+
+		_, err = cmdV1.Parse(msg)
+		test.ErrorIs(err, errNewerSchemaVersion)
+	})
+	test.Run("missing upgrade", func() {
+		msg, err := cmdV1.NewMessage(v1{Name: "old"})
+		test.Require().NoError(err)
+
+		// Declared without NewCommand, so it has a version but no upgrades
+		_, err = Command[TestPayload]{Module: "test", Component: "payload", Method: "upgrade", version: 2}.Parse(msg)
+		test.ErrorIs(err, errMissingUpgrade)
+	})
+}
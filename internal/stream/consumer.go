@@ -0,0 +1,188 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// commandAckWait is how long JetStream waits for a command to be acknowledged, commands
+	// that run longer report progress every commandProgressInterval.
+	commandAckWait          = 30 * time.Second
+	commandProgressInterval = 10 * time.Second
+	// commandFetchWait is how long a fetch waits for a command before trying again.
+	commandFetchWait = 5 * time.Second
+	// commandFetchBackoff is the wait after a failed fetch.
+	commandFetchBackoff = time.Second
+	// consumerRetryDelay is the wait before a message that failed is delivered again.
+	consumerRetryDelay = time.Second
+	// commandMaxDeliver bounds redeliveries of a command that could not be handled, a
+	// command that was claimed and run is acknowledged on redelivery.
+	commandMaxDeliver = 5
+)
+
+// semaphore bounds how many commands are handled at once.
+type semaphore chan struct{}
+
+func newSemaphore(size int) semaphore {
+	return make(semaphore, size)
+}
+
+func (s semaphore) acquire() {
+	s <- struct{}{}
+}
+
+func (s semaphore) release() {
+	<-s
+}
+
+func deliverPolicy() (nats.DeliverPolicy, error) {
+	switch environment.GetNATSDeliveryPolicy() {
+	case "all":
+		return nats.DeliverAllPolicy, nil
+	case "last":
+		return nats.DeliverLastPolicy, nil
+	default:
+		return 0, fmt.Errorf("unknown delivery policy: %s", environment.GetNATSDeliveryPolicy())
+	}
+}
+
+// ensurePullConsumer creates a durable pull consumer, or updates it so that a changed
+// concurrency takes effect. Every replica binds to the same consumer and JetStream hands
+// each message to one of them. A durable left behind as a push consumer, or with a
+// policy that can not be updated, is deleted and created again.
+func (ns *NATSStream) ensurePullConsumer(subject, durable string, maxAckPending, maxDeliver int) error {
+	policy, err := deliverPolicy()
+	if err != nil {
+		return err
+	}
+
+	cfg := &nats.ConsumerConfig{
+		Durable:       durable,
+		FilterSubject: subject,
+		DeliverPolicy: policy,
+		AckPolicy:     nats.AckExplicitPolicy,
+		AckWait:       commandAckWait,
+		MaxDeliver:    maxDeliver,
+		MaxAckPending: maxAckPending,
+	}
+
+	info, err := ns.jt.ConsumerInfo(streamName, durable)
+	switch {
+	case errors.Is(err, nats.ErrConsumerNotFound):
+		_, err = ns.jt.AddConsumer(streamName, cfg)
+	case err == nil && !pullCompatible(info.Config, cfg):
+		log.Info().Str("durable", durable).Msg("recreating consumer")
+
+		if err = ns.jt.DeleteConsumer(streamName, durable); err == nil {
+			_, err = ns.jt.AddConsumer(streamName, cfg)
+		}
+	case err == nil:
+		_, err = ns.jt.UpdateConsumer(streamName, cfg)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating consumer %s: %w", durable, err)
+	}
+
+	return nil
+}
+
+// deleteLegacyConsumer removes a consumer that is no longer used.
+func deleteLegacyConsumer(jt nats.JetStreamContext, durable string) error {
+	err := jt.DeleteConsumer(streamName, durable)
+	if err != nil && !errors.Is(err, nats.ErrConsumerNotFound) {
+		return fmt.Errorf("error deleting consumer %s: %w", durable, err)
+	}
+
+	return nil
+}
+
+// pullCompatible tells if existing can be updated in place to cfg.
+func pullCompatible(existing nats.ConsumerConfig, cfg *nats.ConsumerConfig) bool {
+	return existing.DeliverSubject == "" &&
+		existing.DeliverPolicy == cfg.DeliverPolicy &&
+		existing.AckPolicy == cfg.AckPolicy
+}
+
+// consume fetches a message whenever the subscriber has a free slot and handles it once
+// the module has a free slot, until the subscription is closed. Messages are acknowledged
+// when handled, rejected for redelivery when handle fails and terminated when the error
+// is not retryable.
+func (ns *NATSStream) consume(sub *nats.Subscription, slots semaphore, handle func(*nats.Msg) error) {
+	for {
+		slots.acquire()
+
+		msgs, err := sub.Fetch(1, nats.MaxWait(commandFetchWait))
+		if err != nil {
+			slots.release()
+
+			if errors.Is(err, nats.ErrTimeout) {
+				continue
+			}
+
+			if !sub.IsValid() {
+				return
+			}
+
+			log.Err(err).Str("subject", sub.Subject).Msg("error fetching command")
+			time.Sleep(commandFetchBackoff)
+
+			continue
+		}
+
+		for _, natsMsg := range msgs {
+			go func(natsMsg *nats.Msg) {
+				defer slots.release()
+
+				ns.limit.acquire()
+				defer ns.limit.release()
+
+				stop := reportProgress(natsMsg)
+				err := handle(natsMsg)
+				stop()
+
+				switch {
+				case err == nil:
+					err = natsMsg.Ack()
+				case errors.Is(err, ErrNonRetryable):
+					err = natsMsg.Term()
+				default:
+					err = natsMsg.NakWithDelay(consumerRetryDelay)
+				}
+
+				if err != nil {
+					log.Err(err).Msg("error acknowledging message")
+				}
+			}(natsMsg)
+		}
+	}
+}
+
+// reportProgress keeps JetStream from redelivering a command while it is handled.
+func reportProgress(natsMsg *nats.Msg) func() {
+	done := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(commandProgressInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := natsMsg.InProgress(); err != nil {
+					log.Err(err).Msg("error reporting progress")
+				}
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
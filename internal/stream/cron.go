@@ -0,0 +1,190 @@
+package stream
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed five field cron expression, minute hour day-of-month month
+// day-of-week, evaluated in UTC.
+type cronSchedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+var cronMacros = map[string]string{ //nolint: gochecknoglobals
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxCronSearch bounds the search for the next run, expressions such as 30 February
+// never match.
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+func parseCron(spec string) (*cronSchedule, error) {
+	if macro, exists := cronMacros[strings.TrimSpace(spec)]; exists {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in cron expression, got %d", len(fields))
+	}
+
+	schedule := &cronSchedule{
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}
+
+	var err error
+
+	if schedule.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("error parsing minute: %w", err)
+	}
+
+	if schedule.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("error parsing hour: %w", err)
+	}
+
+	if schedule.dayOfMonth, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("error parsing day of month: %w", err)
+	}
+
+	if schedule.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("error parsing month: %w", err)
+	}
+
+	if schedule.dayOfWeek, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("error parsing day of week: %w", err)
+	}
+
+	// Both 0 and 7 are sunday
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+
+	if schedule.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("cron expression %s never runs", spec)
+	}
+
+	return schedule, nil
+}
+
+func parseCronField(field string, low, high int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+
+		if rangePart, stepPart, hasStep := strings.Cut(part, "/"); hasStep {
+			var err error
+
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step: %s", stepPart)
+			}
+
+			part = rangePart
+		}
+
+		start, end := low, high
+
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			startPart, endPart, _ := strings.Cut(part, "-")
+
+			var err error
+
+			if start, err = strconv.Atoi(startPart); err != nil {
+				return 0, fmt.Errorf("invalid value: %s", startPart)
+			}
+
+			if end, err = strconv.Atoi(endPart); err != nil {
+				return 0, fmt.Errorf("invalid value: %s", endPart)
+			}
+		default:
+			value, err := strconv.Atoi(part)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value: %s", part)
+			}
+
+			start = value
+			if step == 1 {
+				end = value
+			}
+		}
+
+		if start < low || end > high || start > end {
+			return 0, fmt.Errorf("value out of range %d-%d: %s", low, high, part)
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+func (cs *cronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := cs.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := cs.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	switch {
+	case cs.anyDayOfMonth && cs.anyDayOfWeek:
+		return true
+	case cs.anyDayOfMonth:
+		return dayOfWeek
+	case cs.anyDayOfWeek:
+		return dayOfMonth
+	default:
+		return dayOfMonth || dayOfWeek
+	}
+}
+
+// Next returns the first time after t that matches the schedule, or the zero time if
+// there is none.
+func (cs *cronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+
+	for t.Before(limit) {
+		if cs.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !cs.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if cs.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if cs.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
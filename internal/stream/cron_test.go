@@ -0,0 +1,68 @@
+package stream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CronTest struct {
+	suite.Suite
+}
+
+func TestCron(t *testing.T) {
+	suite.Run(t, new(CronTest))
+}
+
+func (test *CronTest) TestParseInvalid() {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"0 0 30 2 *",
+	} {
+		_, err := parseCron(spec)
+		test.Error(err, spec)
+	}
+}
+
+func (test *CronTest) TestNext() {
+	from := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC) // monday
+
+	type testCase struct {
+		spec     string
+		expected time.Time
+	}
+
+	testCases := []testCase{
+		{"* * * * *", time.Date(2024, 1, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 15, 10, 45, 0, 0, time.UTC)},
+		{"30 * * * *", time.Date(2024, 1, 15, 11, 30, 0, 0, time.UTC)},
+		{"0 22 * * *", time.Date(2024, 1, 15, 22, 0, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 6,7", time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 0", time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		test.Run(tc.spec, func() {
+			cron, err := parseCron(tc.spec)
+			test.Require().NoError(err)
+			test.Equal(tc.expected, cron.Next(from))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+)
+
+// eventMaxDeliver is how many times an event is delivered to a subscriber that fails to
+// handle it.
+const eventMaxDeliver = 5
+
+func eventSubject(module, component, method string) string {
+	return fmt.Sprintf("foreverbull.%s.%s.%s.event", module, component, method)
+}
+
+// EventSubscriber calls cb with the command every time a command of the given module,
+// component and method has finished. The consumer is durable and named after the
+// subscribing module, every module gets its own copy of the event, replicas of a module
+// share it and events published while the module is down are delivered once it is back.
+func (ns *NATSStream) EventSubscriber(module, component, method string, cb func(context.Context, Message) error) error {
+	handle := func(natsMsg *nats.Msg) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Err(fmt.Errorf("panic: %v", r)).Stack().Str("module", module).Str("component", component).
+					Str("method", method).Msg("panic in event subscriber")
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+
+		msg := &message{}
+
+		if err := json.Unmarshal(natsMsg.Data, msg); err != nil || msg.ID == nil {
+			log.Error().Err(err).Msg("invalid event")
+			return NonRetryable(errors.New("invalid event"))
+		}
+
+		log := log.With().Str("id", *msg.ID).Str("module", module).Str("component", component).Str("method", method).Logger()
+		log.Debug().Msg("received event")
+
+		msg.dependencyContainer = ns.deps
+
+		if err := cb(context.Background(), msg); err != nil {
+			log.Err(err).Msg("error handling event")
+			return err
+		}
+
+		return nil
+	}
+
+	subject := eventSubject(module, component, method)
+	durable := fmt.Sprintf("foreverbull-%s-%s-%s-%s-event", ns.module, module, component, method)
+
+	if err := ns.ensurePullConsumer(subject, durable, 1, eventMaxDeliver); err != nil {
+		return err
+	}
+
+	sub, err := ns.jt.PullSubscribe(subject, durable, nats.Bind(streamName, durable))
+	if err != nil {
+		return fmt.Errorf("error subscribing to events: %w", err)
+	}
+
+	go ns.consume(sub, newSemaphore(1), handle)
+
+	ns.subs = append(ns.subs, sub)
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/lhjnilsson/foreverbull/internal/storage"
+	"github.com/rs/zerolog/log"
+	"go.uber.org/fx"
+)
+
+const (
+	// janitorInterval is how often expired messages are purged.
+	janitorInterval = time.Hour
+	// janitorBatchSize is how many orchestrations and messages are purged in one pass.
+	janitorBatchSize = 100
+	// janitorLockID is the advisory lock that keeps replicas from purging at the same time.
+	janitorLockID = 0x666a616e
+)
+
+// RetentionPolicy is how long finished messages are kept before they are archived and
+// removed, failed orchestrations are usually kept longer.
+type RetentionPolicy struct {
+	Completed time.Duration
+	Failed    time.Duration
+}
+
+// PurgeResult is what a single janitor pass removed.
+type PurgeResult struct {
+	Orchestrations int
+	Messages       int64
+	Archived       int
+}
+
+// Janitor archives the payloads of finished orchestrations and messages to the results
+// bucket once they are past retention, and removes them from the message tables. With the
+// memory backend the messages are removed from the bus without being archived.
+type Janitor struct {
+	repository repository
+	storage    storage.Storage
+	lock       *leaderLock
+	bus        *MemoryBus
+	policy     RetentionPolicy
+
+	stop context.CancelFunc
+	done chan struct{}
+}
+
+func NewJanitor(pool *pgxpool.Pool, storage storage.Storage, policy RetentionPolicy) *Janitor {
+	return &Janitor{
+		repository: NewRepository(pool),
+		storage:    storage,
+		lock:       newLeaderLock(pool, janitorLockID),
+		policy:     policy,
+	}
+}
+
+func NewMemoryJanitor(bus *MemoryBus, policy RetentionPolicy) *Janitor {
+	return &Janitor{
+		bus:    bus,
+		policy: policy,
+	}
+}
+
+func archiveName(expired expiredMessages) string {
+	if expired.Orchestration {
+		return fmt.Sprintf("stream/orchestrations/%s.json", expired.ID)
+	}
+
+	return fmt.Sprintf("stream/messages/%s.json", expired.ID)
+}
+
+func (j *Janitor) archive(ctx context.Context, expired expiredMessages) error {
+	var messages []message
+
+	if expired.Orchestration {
+		o, err := j.repository.GetOrchestration(ctx, expired.ID)
+		if err != nil {
+			return fmt.Errorf("error getting orchestration: %w", err)
+		}
+
+		messages = o.Messages
+	} else {
+		msg, err := j.repository.GetMessage(ctx, expired.ID)
+		if err != nil {
+			return fmt.Errorf("error getting message: %w", err)
+		}
+
+		messages = []message{*msg}
+	}
+
+	data, err := json.Marshal(messages)
+	if err != nil {
+		return fmt.Errorf("error marshalling messages: %w", err)
+	}
+
+	_, err = j.storage.PutObject(ctx, storage.ResultsBucket, archiveName(expired), data)
+	if err != nil {
+		return fmt.Errorf("error archiving messages: %w", err)
+	}
+
+	return nil
+}
+
+// Purge archives and removes everything that is past retention at the given time.
+func (j *Janitor) Purge(ctx context.Context, now time.Time) (*PurgeResult, error) {
+	if j.bus != nil {
+		return j.bus.purge(now.Add(-j.policy.Completed), now.Add(-j.policy.Failed)), nil
+	}
+
+	result := &PurgeResult{}
+
+	for {
+		expired, err := j.repository.ListExpiredMessages(ctx, now.Add(-j.policy.Completed),
+			now.Add(-j.policy.Failed), janitorBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("error listing expired messages: %w", err)
+		}
+
+		for _, e := range *expired {
+			if err := j.archive(ctx, e); err != nil {
+				return result, err
+			}
+
+			result.Archived++
+
+			var deleted int64
+			if e.Orchestration {
+				deleted, err = j.repository.DeleteOrchestration(ctx, e.ID)
+				result.Orchestrations++
+			} else {
+				deleted, err = j.repository.DeleteMessage(ctx, e.ID)
+			}
+
+			if err != nil {
+				return result, err
+			}
+
+			result.Messages += deleted
+		}
+
+		if len(*expired) < janitorBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// sweep purges expired messages unless another replica is already doing it.
+func (j *Janitor) sweep(ctx context.Context) {
+	if j.lock != nil {
+		acquired, err := j.lock.tryAcquire(ctx)
+		if err != nil {
+			log.Err(err).Msg("error taking janitor lock")
+			return
+		}
+
+		if !acquired {
+			return
+		}
+
+		defer j.lock.release()
+	}
+
+	result, err := j.Purge(ctx, time.Now())
+
+	if err != nil {
+		log.Err(err).Int("orchestrations", result.Orchestrations).Int64("messages", result.Messages).
+			Int("archived", result.Archived).Msg("error purging expired messages")
+
+		return
+	}
+
+	log.Info().Int("orchestrations", result.Orchestrations).Int64("messages", result.Messages).
+		Int("archived", result.Archived).Msg("purged expired messages")
+}
+
+// run sweeps once at startup and then every janitorInterval.
+func (j *Janitor) run(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(janitorInterval)
+	defer ticker.Stop()
+
+	for {
+		j.sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *Janitor) Start() error {
+	if j.lock == nil && j.bus == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	j.stop = cancel
+	j.done = make(chan struct{})
+
+	go j.run(ctx)
+
+	return nil
+}
+
+func (j *Janitor) Stop() error {
+	if j.stop == nil {
+		return nil
+	}
+
+	j.stop()
+	<-j.done
+
+	return nil
+}
+
+var JanitorLifecycle = fx.Options( //nolint: gochecknoglobals
+	fx.Provide(
+		func(pool *pgxpool.Pool, storage storage.Storage) *Janitor {
+			policy := RetentionPolicy{
+				Completed: environment.GetStreamRetentionCompleted(),
+				Failed:    environment.GetStreamRetentionFailed(),
+			}
+			if environment.GetStreamBackend() == MemoryBackend {
+				return NewMemoryJanitor(memoryBus, policy)
+			}
+			return NewJanitor(pool, storage, policy)
+		},
+	),
+	fx.Invoke(
+		func(lc fx.Lifecycle, janitor *Janitor) {
+			lc.Append(fx.Hook{
+				OnStart: func(ctx context.Context) error {
+					return janitor.Start()
+				},
+				OnStop: func(ctx context.Context) error {
+					return janitor.Stop()
+				},
+			})
+		}),
+)
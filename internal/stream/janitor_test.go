@@ -0,0 +1,150 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/lhjnilsson/foreverbull/internal/storage"
+	"github.com/lhjnilsson/foreverbull/internal/test_helper"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type JanitorTest struct {
+	suite.Suite
+
+	db      *pgxpool.Pool
+	storage *storage.MockStorage
+	janitor *Janitor
+}
+
+func (test *JanitorTest) SetupTest() {
+	test_helper.SetupEnvironment(test.T(), &test_helper.Containers{Postgres: true})
+
+	var err error
+	test.db, err = pgxpool.New(context.TODO(), environment.GetPostgresURL())
+	test.Require().NoError(err)
+	test.Require().NoError(RecreateTables(context.TODO(), test.db))
+
+	test.storage = new(storage.MockStorage)
+	test.janitor = NewJanitor(test.db, test.storage, RetentionPolicy{
+		Completed: time.Hour * 24,
+		Failed:    time.Hour * 24 * 7,
+	})
+}
+
+func (test *JanitorTest) TearDownTest() {
+	test.db.Close()
+}
+
+func TestJanitor(t *testing.T) {
+	suite.Run(t, new(JanitorTest))
+}
+
+// createOrchestration stores an orchestration where every message ended in the given
+// status the given time ago.
+func (test *JanitorTest) createOrchestration(status MessageStatus, age time.Duration) string {
+	orchestration := NewMessageOrchestration("janitor")
+
+	msg1, err := NewMessage("test", "return", "nil", TestPayload{Name: "step1"})
+	test.Require().NoError(err)
+	orchestration.AddStep("step1", []Message{msg1})
+
+	msg2, err := NewMessage("test", "return", "nil", TestPayload{Name: "fallback"})
+	test.Require().NoError(err)
+	orchestration.SettFallback([]Message{msg2})
+
+	for _, msg := range []Message{msg1, msg2} {
+		test.Require().NoError(test.janitor.repository.CreateMessage(context.TODO(), msg.(*message)))
+		test.setStatus(msg.GetID(), status, age)
+	}
+
+	return orchestration.OrchestrationID
+}
+
+func (test *JanitorTest) setStatus(messageID string, status MessageStatus, age time.Duration) {
+	test.Require().NoError(test.janitor.repository.UpdateMessageStatus(context.TODO(), messageID, status, nil))
+	_, err := test.db.Exec(context.TODO(), `UPDATE message_status SET occurred_at=$1 WHERE message_id=$2`,
+		time.Now().Add(-age), messageID)
+	test.Require().NoError(err)
+}
+
+func (test *JanitorTest) TestPurge() {
+	completed := test.createOrchestration(MessageStatusComplete, time.Hour*48)
+	recent := test.createOrchestration(MessageStatusComplete, time.Hour)
+	failed := test.createOrchestration(MessageStatusError, time.Hour*48)
+	expiredFailure := test.createOrchestration(MessageStatusError, time.Hour*24*8)
+
+	standalone := message{Module: "test", Component: "return", Method: "nil"}
+	test.Require().NoError(test.janitor.repository.CreateMessage(context.TODO(), &standalone))
+	test.setStatus(*standalone.ID, MessageStatusCanceled, time.Hour*48)
+
+	active := message{Module: "test", Component: "return", Method: "nil"}
+	test.Require().NoError(test.janitor.repository.CreateMessage(context.TODO(), &active))
+	test.setStatus(*active.ID, MessageStatusPublished, time.Hour*24*30)
+
+	archived := map[string][]message{}
+	test.storage.On("PutObject", mock.Anything, storage.ResultsBucket, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			messages := []message{}
+			test.Require().NoError(json.Unmarshal(args.Get(3).([]byte), &messages))
+			archived[args.String(2)] = messages
+		}).Return(&storage.Object{}, nil)
+
+	result, err := test.janitor.Purge(context.TODO(), time.Now())
+	test.Require().NoError(err)
+	test.Equal(2, result.Orchestrations)
+	test.Equal(int64(5), result.Messages)
+	test.Equal(3, result.Archived)
+
+	test.Len(archived["stream/orchestrations/"+completed+".json"], 2)
+	test.Len(archived["stream/orchestrations/"+expiredFailure+".json"], 2)
+	test.Len(archived["stream/messages/"+*standalone.ID+".json"], 1)
+
+	for _, orchestrationID := range []string{recent, failed} {
+		_, err := test.janitor.repository.GetOrchestration(context.TODO(), orchestrationID)
+		test.NoError(err)
+	}
+
+	msg, err := test.janitor.repository.GetMessage(context.TODO(), *active.ID)
+	test.Require().NoError(err)
+	test.NotEmpty(msg.StatusHistory)
+}
+
+func (test *JanitorTest) TestArchiveFailed() {
+	test.createOrchestration(MessageStatusComplete, time.Hour*48)
+
+	test.storage.On("PutObject", mock.Anything, storage.ResultsBucket, mock.Anything, mock.Anything).
+		Return(nil, errors.New("storage down"))
+
+	result, err := test.janitor.Purge(context.TODO(), time.Now())
+	test.Require().Error(err)
+	test.Equal(0, result.Orchestrations)
+
+	expired, err := test.janitor.repository.ListExpiredMessages(context.TODO(), time.Now().Add(-time.Hour*24),
+		time.Now().Add(-time.Hour*24*7), janitorBatchSize)
+	test.Require().NoError(err)
+	test.Len(*expired, 1)
+}
+
+func (test *JanitorTest) TestSweepOnStart() {
+	expired := message{Module: "test", Component: "return", Method: "nil"}
+	test.Require().NoError(test.janitor.repository.CreateMessage(context.TODO(), &expired))
+	test.setStatus(*expired.ID, MessageStatusComplete, time.Hour*48)
+
+	test.storage.On("PutObject", mock.Anything, storage.ResultsBucket, mock.Anything, mock.Anything).
+		Return(&storage.Object{}, nil)
+
+	test.Require().NoError(test.janitor.Start())
+	defer func() { test.NoError(test.janitor.Stop()) }()
+
+	test.Eventually(func() bool {
+		_, err := test.janitor.repository.GetMessage(context.TODO(), *expired.ID)
+		return err != nil
+	}, time.Second*5, time.Millisecond*50)
+}
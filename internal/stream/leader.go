@@ -0,0 +1,76 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// orchestrationLockID is the Postgres advisory lock held by the replica that runs
+// orchestrations.
+const orchestrationLockID = 0x666f7265
+
+// leaderLock is a session level advisory lock, it is held for as long as the connection
+// that took it is alive so a replica that dies releases it.
+type leaderLock struct {
+	pool *pgxpool.Pool
+	id   int64
+	conn *pgxpool.Conn
+}
+
+func newLeaderLock(pool *pgxpool.Pool, id int64) *leaderLock {
+	return &leaderLock{pool: pool, id: id}
+}
+
+// tryAcquire takes the lock if no other replica holds it.
+func (l *leaderLock) tryAcquire(ctx context.Context) (bool, error) {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	var acquired bool
+
+	err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, l.id).Scan(&acquired)
+	if err != nil {
+		conn.Release()
+		return false, fmt.Errorf("failed to take advisory lock: %w", err)
+	}
+
+	if !acquired {
+		conn.Release()
+		return false, nil
+	}
+
+	l.conn = conn
+
+	return true, nil
+}
+
+// check returns an error if the connection holding the lock is gone.
+func (l *leaderLock) check(ctx context.Context) error {
+	if err := l.conn.Ping(ctx); err != nil {
+		return fmt.Errorf("failed to ping lock connection: %w", err)
+	}
+
+	return nil
+}
+
+func (l *leaderLock) release() {
+	if l.conn == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := l.conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, l.id); err != nil {
+		// Closing the connection releases the lock as well
+		l.conn.Conn().Close(ctx) //nolint: errcheck
+	}
+
+	l.conn.Release()
+	l.conn = nil
+}
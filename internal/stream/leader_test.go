@@ -0,0 +1,73 @@
+package stream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/lhjnilsson/foreverbull/internal/test_helper"
+	"github.com/stretchr/testify/suite"
+)
+
+type LeaderLockTest struct {
+	suite.Suite
+
+	db *pgxpool.Pool
+}
+
+func (test *LeaderLockTest) SetupTest() {
+	test_helper.SetupEnvironment(test.T(), &test_helper.Containers{Postgres: true})
+
+	var err error
+	test.db, err = pgxpool.New(context.TODO(), environment.GetPostgresURL())
+	test.Require().NoError(err)
+}
+
+func (test *LeaderLockTest) TearDownTest() {
+	test.db.Close()
+}
+
+func TestLeaderLock(t *testing.T) {
+	suite.Run(t, new(LeaderLockTest))
+}
+
+func (test *LeaderLockTest) TestElection() {
+	first := newLeaderLock(test.db, orchestrationLockID)
+	second := newLeaderLock(test.db, orchestrationLockID)
+
+	acquired, err := first.tryAcquire(context.TODO())
+	test.Require().NoError(err)
+	test.True(acquired)
+	test.NoError(first.check(context.TODO()))
+
+	acquired, err = second.tryAcquire(context.TODO())
+	test.Require().NoError(err)
+	test.False(acquired)
+
+	first.release()
+
+	acquired, err = second.tryAcquire(context.TODO())
+	test.Require().NoError(err)
+	test.True(acquired)
+	second.release()
+}
+
+func (test *LeaderLockTest) TestConnectionLost() {
+	first := newLeaderLock(test.db, orchestrationLockID)
+	second := newLeaderLock(test.db, orchestrationLockID)
+
+	acquired, err := first.tryAcquire(context.TODO())
+	test.Require().NoError(err)
+	test.True(acquired)
+
+	test.Require().NoError(first.conn.Conn().Close(context.TODO()))
+	test.Error(first.check(context.TODO()))
+
+	acquired, err = second.tryAcquire(context.TODO())
+	test.Require().NoError(err)
+	test.True(acquired)
+
+	first.release()
+	second.release()
+}
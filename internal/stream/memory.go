@@ -0,0 +1,789 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/rs/zerolog/log"
+)
+
+const memorySubscriberBuffer = 1024
+
+// MemoryBus holds the messages and subscribers shared by every MemoryStream in the process,
+// it takes the place of both JetStream and the message tables.
+type MemoryBus struct {
+	lock sync.Mutex
+
+	messages    map[string]*message
+	subscribers map[string]*memorySubscriber
+	pending     map[string][]string
+	consumers   map[string]*memoryConsumer
+}
+
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{
+		messages:    make(map[string]*message),
+		subscribers: make(map[string]*memorySubscriber),
+		pending:     make(map[string][]string),
+		consumers:   make(map[string]*memoryConsumer),
+	}
+}
+
+type memorySubscriber struct {
+	messages chan string
+	done     chan struct{}
+}
+
+// memoryConsumer is the in-memory version of a durable event consumer, events are kept
+// while nothing is subscribed and delivered once a handler is attached again.
+type memoryConsumer struct {
+	subject string
+	handler func(*message)
+	pending []*message
+}
+
+func memorySubject(module, component, method string) string {
+	return fmt.Sprintf("%s.%s.%s", module, component, method)
+}
+
+func (mb *MemoryBus) create(msg *message) {
+	mb.createWithStatus(msg, MessageStatusCreated)
+}
+
+func (mb *MemoryBus) createWithStatus(msg *message, status MessageStatus) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	id := uuid.New().String()
+	msg.ID = &id
+	msg.setStatus(status, nil)
+	mb.messages[id] = msg
+}
+
+// claim moves a message from the given status to published, returning false when the
+// message is no longer in that status.
+func (mb *MemoryBus) claim(id string, from MessageStatus) bool {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	msg, exists := mb.messages[id]
+	if !exists || msg.StatusHistory[0].Status != from {
+		return false
+	}
+
+	msg.setStatus(MessageStatusPublished, nil)
+
+	return true
+}
+
+func (mb *MemoryBus) publish(id string) error {
+	mb.lock.Lock()
+
+	msg, exists := mb.messages[id]
+	if !exists {
+		mb.lock.Unlock()
+		return fmt.Errorf("message not found: %s", id)
+	}
+
+	msg.setStatus(MessageStatusPublished, nil)
+
+	subject := memorySubject(msg.Module, msg.Component, msg.Method)
+
+	subscriber, exists := mb.subscribers[subject]
+	if !exists {
+		mb.pending[subject] = append(mb.pending[subject], id)
+		mb.lock.Unlock()
+
+		return nil
+	}
+	mb.lock.Unlock()
+
+	go func() {
+		select {
+		case subscriber.messages <- id:
+		case <-subscriber.done:
+		}
+	}()
+
+	return nil
+}
+
+func (mb *MemoryBus) subscribe(subject string) (*memorySubscriber, error) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	if _, exists := mb.subscribers[subject]; exists {
+		return nil, fmt.Errorf("subject already has a subscriber: %s", subject)
+	}
+
+	subscriber := &memorySubscriber{
+		messages: make(chan string, memorySubscriberBuffer),
+		done:     make(chan struct{}),
+	}
+	mb.subscribers[subject] = subscriber
+
+	pending := mb.pending[subject]
+	delete(mb.pending, subject)
+
+	go func() {
+		for _, id := range pending {
+			select {
+			case subscriber.messages <- id:
+			case <-subscriber.done:
+				return
+			}
+		}
+	}()
+
+	return subscriber, nil
+}
+
+func (mb *MemoryBus) subscribeEvents(durable, subject string, handler func(*message)) error {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	consumer, exists := mb.consumers[durable]
+	if !exists {
+		consumer = &memoryConsumer{subject: subject}
+		mb.consumers[durable] = consumer
+	}
+
+	if consumer.handler != nil {
+		return fmt.Errorf("consumer already has a subscriber: %s", durable)
+	}
+
+	consumer.handler = handler
+
+	for _, event := range consumer.pending {
+		go handler(event)
+	}
+
+	consumer.pending = nil
+
+	return nil
+}
+
+func (mb *MemoryBus) unsubscribeEvents(durable string) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	if consumer, exists := mb.consumers[durable]; exists {
+		consumer.handler = nil
+	}
+}
+
+// publishEvent hands a copy of the finished command to every consumer of the subject.
+func (mb *MemoryBus) publishEvent(id string) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	msg, exists := mb.messages[id]
+	if !exists {
+		return
+	}
+
+	subject := memorySubject(msg.Module, msg.Component, msg.Method)
+
+	for _, consumer := range mb.consumers {
+		if consumer.subject != subject {
+			continue
+		}
+
+		event := *msg
+		event.StatusHistory = append([]messageStatus{}, msg.StatusHistory...)
+
+		if consumer.handler == nil {
+			consumer.pending = append(consumer.pending, &event)
+			continue
+		}
+
+		go consumer.handler(&event)
+	}
+}
+
+func (mb *MemoryBus) unsubscribe(subject string) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	subscriber, exists := mb.subscribers[subject]
+	if !exists {
+		return
+	}
+
+	close(subscriber.done)
+	delete(mb.subscribers, subject)
+}
+
+// receive moves a published message to received and returns a copy for the handler.
+func (mb *MemoryBus) receive(id string) (*message, bool) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	msg, exists := mb.messages[id]
+	if !exists || msg.StatusHistory[0].Status != MessageStatusPublished {
+		return nil, false
+	}
+
+	msg.Attempts++
+	msg.setStatus(MessageStatusReceived, nil)
+
+	received := *msg
+
+	return &received, true
+}
+
+func (mb *MemoryBus) updateStatus(id string, status MessageStatus, err error) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	if msg, exists := mb.messages[id]; exists {
+		msg.setStatus(status, err)
+	}
+}
+
+func (mb *MemoryBus) getMessage(id string) (*message, error) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	msg, exists := mb.messages[id]
+	if !exists {
+		return nil, fmt.Errorf("message not found: %s", id)
+	}
+
+	m := *msg
+
+	return &m, nil
+}
+
+func (mb *MemoryBus) orchestrationMessages(orchestrationID string) []*message {
+	msgs := []*message{}
+
+	for _, msg := range mb.messages {
+		if msg.GetOrchestrationID() == orchestrationID {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	return msgs
+}
+
+// purge removes standalone messages and orchestrations where every message has finished and
+// the last status change is past retention, following the same rules as ListExpiredMessages.
+func (mb *MemoryBus) purge(completedBefore, failedBefore time.Time) *PurgeResult {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	type group struct {
+		ids           []string
+		orchestration bool
+		finished      bool
+		failed        bool
+		last          time.Time
+	}
+
+	groups := map[string]*group{}
+
+	for id, msg := range mb.messages {
+		key := id
+		if msg.OrchestrationID != nil {
+			key = *msg.OrchestrationID
+		}
+
+		g, exists := groups[key]
+		if !exists {
+			g = &group{orchestration: msg.OrchestrationID != nil, finished: true}
+			groups[key] = g
+		}
+
+		status := msg.StatusHistory[0]
+		g.ids = append(g.ids, id)
+		g.finished = g.finished && (status.Status == MessageStatusComplete ||
+			status.Status == MessageStatusError || status.Status == MessageStatusCanceled)
+		g.failed = g.failed || status.Status == MessageStatusError
+
+		if status.OccurredAt.After(g.last) {
+			g.last = status.OccurredAt
+		}
+	}
+
+	result := &PurgeResult{}
+
+	for _, g := range groups {
+		before := completedBefore
+		if g.failed {
+			before = failedBefore
+		}
+
+		if !g.finished || !g.last.Before(before) {
+			continue
+		}
+
+		for _, id := range g.ids {
+			delete(mb.messages, id)
+		}
+
+		if g.orchestration {
+			result.Orchestrations++
+		}
+
+		result.Messages += int64(len(g.ids))
+	}
+
+	return result
+}
+
+func isFallback(msg *message) bool {
+	return msg.OrchestrationFallbackStep != nil && *msg.OrchestrationFallbackStep
+}
+
+// isCompensation tells compensations apart from the fallback, they are stored as part of the
+// fallback but keep the step number of the step they undo.
+func isCompensation(msg *message) bool {
+	return isFallback(msg) && msg.OrchestrationStepNumber != nil
+}
+
+func dependsOn(msg, parent *message) bool {
+	if isFallback(parent) {
+		return false
+	}
+
+	if msg.OrchestrationStepDependsOn == nil {
+		return msg.OrchestrationStepNumber != nil && parent.OrchestrationStepNumber != nil &&
+			*parent.OrchestrationStepNumber == *msg.OrchestrationStepNumber-1
+	}
+
+	for _, step := range msg.OrchestrationStepDependsOn {
+		if step == parent.GetOrchestrationStep() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nextOrchestrationCommands follows the same rules as the repository, see GetNextOrchestrationCommands.
+func (mb *MemoryBus) nextOrchestrationCommands(orchestrationID string) (complete bool, commands []string, fallback bool) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	msgs := mb.orchestrationMessages(orchestrationID)
+
+	complete = true
+	failed := false
+	inFlight := false
+
+	stepComplete := map[string]bool{}
+
+	for _, msg := range msgs {
+		status := msg.StatusHistory[0].Status
+		inFlight = inFlight || status == MessageStatusPublished || status == MessageStatusReceived ||
+			status == MessageStatusRetry
+
+		if isFallback(msg) {
+			failed = failed || status == MessageStatusError
+
+			continue
+		}
+
+		done, exists := stepComplete[msg.GetOrchestrationStep()]
+		stepComplete[msg.GetOrchestrationStep()] = (done || !exists) && status == MessageStatusComplete
+
+		complete = complete && status == MessageStatusComplete
+		failed = failed || status == MessageStatusError || status == MessageStatusCanceled
+	}
+
+	if complete {
+		return true, nil, false
+	}
+
+	if failed {
+		if inFlight {
+			return false, nil, false
+		}
+
+		commands, fallback = nextCompensations(msgs, stepComplete)
+
+		return false, commands, fallback
+	}
+
+	for _, msg := range msgs {
+		if isFallback(msg) || msg.StatusHistory[0].Status != MessageStatusCreated {
+			continue
+		}
+
+		ready := true
+
+		for _, parent := range msgs {
+			if dependsOn(msg, parent) && parent.StatusHistory[0].Status != MessageStatusComplete {
+				ready = false
+
+				break
+			}
+		}
+
+		if ready {
+			commands = append(commands, *msg.ID)
+		}
+	}
+
+	return false, commands, false
+}
+
+// nextCompensations returns the compensations of the last completed steps that have not run,
+// once there are none left the fallback is returned.
+func nextCompensations(msgs []*message, stepComplete map[string]bool) (commands []string, fallback bool) {
+	stepNumber := -1
+
+	for _, msg := range msgs {
+		if isCompensation(msg) && msg.StatusHistory[0].Status == MessageStatusCreated &&
+			stepComplete[msg.GetOrchestrationStep()] && *msg.OrchestrationStepNumber > stepNumber {
+			stepNumber = *msg.OrchestrationStepNumber
+		}
+	}
+
+	for _, msg := range msgs {
+		if msg.StatusHistory[0].Status != MessageStatusCreated || !isFallback(msg) {
+			continue
+		}
+
+		switch {
+		case stepNumber < 0 && !isCompensation(msg):
+			commands = append(commands, *msg.ID)
+		case isCompensation(msg) && stepComplete[msg.GetOrchestrationStep()] && *msg.OrchestrationStepNumber == stepNumber:
+			commands = append(commands, *msg.ID)
+		}
+	}
+
+	return commands, stepNumber < 0
+}
+
+func (mb *MemoryBus) cancelCreated(orchestrationID string) {
+	mb.lock.Lock()
+	defer mb.lock.Unlock()
+
+	for _, msg := range mb.orchestrationMessages(orchestrationID) {
+		if msg.StatusHistory[0].Status == MessageStatusCreated {
+			msg.setStatus(MessageStatusCanceled, nil)
+		}
+	}
+}
+
+// continueOrchestration is the in-memory version of the OrchestrationRunner, it is called
+// whenever a command in the orchestration has finished.
+func (mb *MemoryBus) continueOrchestration(orchestrationID string) error {
+	complete, commands, fallback := mb.nextOrchestrationCommands(orchestrationID)
+	if complete {
+		mb.cancelCreated(orchestrationID)
+		return nil
+	}
+
+	for _, id := range commands {
+		if !mb.claim(id, MessageStatusCreated) {
+			continue
+		}
+
+		if err := mb.publish(id); err != nil {
+			return fmt.Errorf("error publishing command: %w", err)
+		}
+	}
+
+	if fallback && len(commands) > 0 {
+		mb.cancelCreated(orchestrationID)
+	}
+
+	return nil
+}
+
+// MemoryStream runs commands, events, retries, schedules and orchestrations in process.
+// Orchestrations can not be canceled and there are no servicers to list or change messages,
+// orchestrations, schedules or dead letters. Finished messages are removed by the janitor
+// once they are past retention.
+type MemoryStream struct {
+	module string
+
+	bus       *MemoryBus
+	subjects  []string
+	consumers []string
+	retries   *retryTimers
+	scheduled *retryTimers
+
+	deps  *dependencyContainer
+	limit semaphore
+}
+
+// NewMemoryStream creates an in-process stream, every stream created on the same bus can
+// publish commands to each other and take part in the same orchestrations.
+func NewMemoryStream(bus *MemoryBus, module string, dependencies DependencyContainer) Stream {
+	return &MemoryStream{
+		module:    module,
+		bus:       bus,
+		retries:   newRetryTimers(),
+		scheduled: newRetryTimers(),
+		deps:      dependencies.(*dependencyContainer),
+		limit:     newSemaphore(environment.GetStreamConcurrency(module)),
+	}
+}
+
+func (ms *MemoryStream) CommandSubscriber(component, method string, cb func(context.Context, Message) error, options ...SubscriberOption) error {
+	subscription := newSubscriberOptions(options...)
+	subject := memorySubject(ms.module, component, method)
+
+	concurrency := subscription.concurrency
+	if concurrency <= 0 || concurrency > cap(ms.limit) {
+		concurrency = cap(ms.limit)
+	}
+
+	slots := newSemaphore(concurrency)
+
+	subscriber, err := ms.bus.subscribe(subject)
+	if err != nil {
+		return fmt.Errorf("error subscribing: %w", err)
+	}
+
+	ms.subjects = append(ms.subjects, subject)
+
+	handle := func(id string) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Err(fmt.Errorf("panic: %v", r)).Stack().Str("component", component).Str("method", method).Msg("panic in command subscriber")
+			}
+		}()
+
+		msg, received := ms.bus.receive(id)
+		if !received {
+			return
+		}
+
+		log := log.With().Str("id", id).Str("component", component).Str("method", method).Logger()
+		log.Info().Msg("received command")
+
+		msg.dependencyContainer = ms.deps
+
+		err := cb(context.Background(), msg)
+		switch {
+		case err == nil:
+			ms.bus.updateStatus(id, MessageStatusComplete, nil)
+		case subscription.retryPolicy.ShouldRetry(msg.Attempts, err):
+			backoff := subscription.retryPolicy.Backoff(msg.Attempts)
+			log.Warn().Err(err).Int("attempt", msg.Attempts).Dur("backoff", backoff).Msg("error executing command, retrying")
+			ms.bus.updateStatus(id, MessageStatusRetry, err)
+			ms.retries.schedule(id, backoff, func() {
+				if ms.bus.claim(id, MessageStatusRetry) {
+					if err := ms.bus.publish(id); err != nil {
+						log.Err(err).Msg("error publishing retry")
+					}
+				}
+			})
+
+			return
+		default:
+			log.Err(err).Int("attempt", msg.Attempts).Msg("error executing command")
+			ms.bus.updateStatus(id, MessageStatusError, err)
+		}
+
+		ms.bus.publishEvent(id)
+
+		if msg.OrchestrationID != nil {
+			if err := ms.bus.continueOrchestration(*msg.OrchestrationID); err != nil {
+				log.Err(err).Msg("error continuing orchestration")
+			}
+		}
+	}
+
+	go func() {
+		for {
+			select {
+			case id := <-subscriber.messages:
+				select {
+				case slots <- struct{}{}:
+				case <-subscriber.done:
+					return
+				}
+
+				go func() {
+					defer slots.release()
+
+					ms.limit.acquire()
+					defer ms.limit.release()
+
+					handle(id)
+				}()
+			case <-subscriber.done:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// EventSubscriber calls cb every time a command of the given module, component and method
+// has finished. Handler errors are only logged, there is no redelivery.
+func (ms *MemoryStream) EventSubscriber(module, component, method string, cb func(context.Context, Message) error) error {
+	durable := fmt.Sprintf("%s.%s", ms.module, memorySubject(module, component, method))
+
+	handle := func(msg *message) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Err(fmt.Errorf("panic: %v", r)).Stack().Str("module", module).Str("component", component).
+					Str("method", method).Msg("panic in event subscriber")
+			}
+		}()
+
+		msg.dependencyContainer = ms.deps
+
+		if err := cb(context.Background(), msg); err != nil {
+			log.Err(err).Str("id", *msg.ID).Str("module", module).Str("component", component).Str("method", method).
+				Msg("error handling event")
+		}
+	}
+
+	if err := ms.bus.subscribeEvents(durable, memorySubject(module, component, method), handle); err != nil {
+		return fmt.Errorf("error subscribing to events: %w", err)
+	}
+
+	ms.consumers = append(ms.consumers, durable)
+
+	return nil
+}
+
+func (ms *MemoryStream) Unsubscribe() error {
+	ms.retries.stop()
+	ms.scheduled.stop()
+
+	for _, subject := range ms.subjects {
+		ms.bus.unsubscribe(subject)
+	}
+
+	for _, durable := range ms.consumers {
+		ms.bus.unsubscribeEvents(durable)
+	}
+
+	ms.subjects = nil
+	ms.consumers = nil
+
+	return nil
+}
+
+func (ms *MemoryStream) Publish(ctx context.Context, msg Message) error {
+	m, isMsg := msg.(*message)
+	if !isMsg {
+		return fmt.Errorf("invalid message")
+	}
+
+	if m.ID == nil {
+		ms.bus.create(m)
+	}
+
+	return ms.bus.publish(*m.ID)
+}
+
+// PublishTx stores the message on the bus until it is published with Publish, the memory
+// bus can not take part in the transaction.
+func (ms *MemoryStream) PublishTx(ctx context.Context, tx pgx.Tx, msg Message) error {
+	m, isMsg := msg.(*message)
+	if !isMsg {
+		return fmt.Errorf("invalid message")
+	}
+
+	if m.ID != nil {
+		return errors.New("message is already stored")
+	}
+
+	ms.bus.create(m)
+
+	return nil
+}
+
+func (ms *MemoryStream) PublishAt(ctx context.Context, msg Message, at time.Time) error {
+	if !at.After(time.Now()) {
+		return ms.Publish(ctx, msg)
+	}
+
+	m, isMsg := msg.(*message)
+	if !isMsg {
+		return fmt.Errorf("invalid message")
+	}
+
+	if m.ID != nil {
+		return errors.New("message is already stored")
+	}
+
+	ms.bus.createWithStatus(m, MessageStatusScheduled)
+	ms.scheduled.schedule(*m.ID, time.Until(at), func() {
+		if ms.bus.claim(*m.ID, MessageStatusScheduled) {
+			if err := ms.bus.publish(*m.ID); err != nil {
+				log.Err(err).Str("id", *m.ID).Msg("error publishing scheduled message")
+			}
+		}
+	})
+
+	return nil
+}
+
+// Schedule keeps the schedule in memory only, it is lost when the stream is unsubscribed.
+func (ms *MemoryStream) Schedule(ctx context.Context, name, spec string, msg Message) error {
+	s, err := newSchedule(name, spec, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	cron, err := parseCron(spec)
+	if err != nil {
+		return fmt.Errorf("error parsing cron expression: %w", err)
+	}
+
+	var fire func()
+	fire = func() {
+		m := &message{Module: s.Module, Component: s.Component, Method: s.Method, Payload: s.Payload, SchemaVersion: s.SchemaVersion}
+		ms.bus.create(m)
+
+		if err := ms.bus.publish(*m.ID); err != nil {
+			log.Err(err).Str("schedule", name).Msg("error publishing scheduled message")
+		}
+
+		ms.scheduled.schedule("schedule:"+name, time.Until(cron.Next(time.Now())), fire)
+	}
+
+	ms.scheduled.schedule("schedule:"+name, time.Until(s.NextRunAt), fire)
+
+	return nil
+}
+
+func (ms *MemoryStream) RunOrchestration(ctx context.Context, orchestration *MessageOrchestration) error {
+	if orchestration.FallbackStep == nil {
+		return fmt.Errorf("orchestration must have fallback step")
+	}
+
+	steps := make([]MessageOrchestrationStep, 0, len(orchestration.Steps)*2+1)
+	steps = append(steps, orchestration.Steps...)
+	steps = append(steps, orchestration.compensations()...)
+	steps = append(steps, *orchestration.FallbackStep)
+
+	for _, step := range steps {
+		for _, cmd := range step.Commands {
+			msg, isMsg := cmd.(*message)
+			if !isMsg {
+				return fmt.Errorf("command is not a message")
+			}
+
+			if msg.OrchestrationID == nil {
+				return fmt.Errorf("orchestration id is nil")
+			}
+
+			if msg.OrchestrationStep == nil {
+				return errors.New("orchestration step is nil")
+			}
+
+			ms.bus.create(msg)
+		}
+	}
+
+	return ms.bus.continueOrchestration(orchestration.OrchestrationID)
+}
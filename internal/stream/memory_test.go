@@ -0,0 +1,430 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MemoryStreamTest struct {
+	suite.Suite
+
+	bus    *MemoryBus
+	stream Stream
+}
+
+func (test *MemoryStreamTest) SetupTest() {
+	test.bus = NewMemoryBus()
+	test.stream = NewMemoryStream(test.bus, "test", NewDependencyContainer())
+
+	test.Require().NoError(test.stream.CommandSubscriber("return", "nil", ReturnNil))
+	test.Require().NoError(test.stream.CommandSubscriber("return", "err", ReturnErr))
+}
+
+func (test *MemoryStreamTest) TearDownTest() {
+	test.NoError(test.stream.Unsubscribe())
+}
+
+func TestMemoryStream(t *testing.T) {
+	suite.Run(t, new(MemoryStreamTest))
+}
+
+func (test *MemoryStreamTest) requireStatus(msg Message, status MessageStatus) {
+	test.Eventually(func() bool {
+		m, err := test.bus.getMessage(msg.GetID())
+		test.Require().NoError(err)
+
+		return m.StatusHistory[0].Status == status
+	}, time.Second, time.Millisecond*10, "expected status %s", status)
+}
+
+func (test *MemoryStreamTest) TestPubSub() {
+	test.Run("nil", func() {
+		msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+		test.requireStatus(msg, MessageStatusComplete)
+	})
+	test.Run("err", func() {
+		msg, err := NewMessage("test", "return", "err", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+		test.requireStatus(msg, MessageStatusError)
+	})
+	test.Run("subscribed after publish", func() {
+		msg, err := NewMessage("test", "late", "nil", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+		test.requireStatus(msg, MessageStatusPublished)
+
+		test.Require().NoError(test.stream.CommandSubscriber("late", "nil", ReturnNil))
+		test.requireStatus(msg, MessageStatusComplete)
+	})
+	test.Run("payload", func() {
+		received := make(chan TestPayload, 1)
+		test.Require().NoError(test.stream.CommandSubscriber("payload", "parse", func(ctx context.Context, msg Message) error {
+			payload := TestPayload{}
+			if err := msg.ParsePayload(&payload); err != nil {
+				return err
+			}
+			received <- payload
+			return nil
+		}))
+
+		msg, err := NewMessage("test", "payload", "parse", TestPayload{Name: "test", Number: 5})
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+		select {
+		case payload := <-received:
+			test.Equal("test", payload.Name)
+			test.Equal(5, payload.Number)
+		case <-time.After(time.Second):
+			test.Fail("payload not received")
+		}
+	})
+}
+
+func (test *MemoryStreamTest) TestRetry() {
+	var calls atomic.Int32
+
+	test.Require().NoError(test.stream.CommandSubscriber("retry", "twice", func(ctx context.Context, msg Message) error {
+		if calls.Add(1) < 2 {
+			return errors.New("not yet")
+		}
+		return nil
+	}, WithRetryPolicy(ExponentialRetryPolicy(3, time.Millisecond, time.Millisecond))))
+
+	msg, err := NewMessage("test", "retry", "twice", nil)
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+	test.requireStatus(msg, MessageStatusComplete)
+	test.Equal(int32(2), calls.Load())
+}
+
+// concurrencyProbe records the highest number of handlers running at once.
+type concurrencyProbe struct {
+	running atomic.Int32
+	max     atomic.Int32
+	done    atomic.Int32
+}
+
+func (cp *concurrencyProbe) handler(ctx context.Context, msg Message) error {
+	running := cp.running.Add(1)
+	for {
+		current := cp.max.Load()
+		if running <= current || cp.max.CompareAndSwap(current, running) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond * 20)
+	cp.running.Add(-1)
+	cp.done.Add(1)
+	return nil
+}
+
+func (test *MemoryStreamTest) TestConcurrency() {
+	test.Run("command", func() {
+		probe := &concurrencyProbe{}
+		test.Require().NoError(test.stream.CommandSubscriber("limited", "command", probe.handler, WithConcurrency(2)))
+
+		for range 6 {
+			msg, err := NewMessage("test", "limited", "command", nil)
+			test.Require().NoError(err)
+			test.Require().NoError(test.stream.Publish(context.Background(), msg))
+		}
+
+		test.Eventually(func() bool { return probe.done.Load() == 6 }, time.Second, time.Millisecond*10)
+		test.Equal(int32(2), probe.max.Load())
+	})
+	test.Run("module", func() {
+		test.T().Setenv("STREAM_CONCURRENCY_LIMITED", "1")
+
+		limited := NewMemoryStream(test.bus, "limited", NewDependencyContainer())
+		defer func() { test.NoError(limited.Unsubscribe()) }()
+
+		probe := &concurrencyProbe{}
+		test.Require().NoError(limited.CommandSubscriber("first", "command", probe.handler))
+		test.Require().NoError(limited.CommandSubscriber("second", "command", probe.handler, WithConcurrency(4)))
+
+		for range 3 {
+			for _, component := range []string{"first", "second"} {
+				msg, err := NewMessage("limited", component, "command", nil)
+				test.Require().NoError(err)
+				test.Require().NoError(limited.Publish(context.Background(), msg))
+			}
+		}
+
+		test.Eventually(func() bool { return probe.done.Load() == 6 }, time.Second, time.Millisecond*10)
+		test.Equal(int32(1), probe.max.Load())
+	})
+}
+
+func (test *MemoryStreamTest) TestEventSubscriber() {
+	other := NewMemoryStream(test.bus, "other", NewDependencyContainer())
+	defer func() { test.NoError(other.Unsubscribe()) }()
+
+	events := make(chan Message, 2)
+	test.Require().NoError(other.EventSubscriber("test", "return", "nil", func(ctx context.Context, msg Message) error {
+		events <- msg
+		return nil
+	}))
+	test.Require().Error(other.EventSubscriber("test", "return", "nil", func(ctx context.Context, msg Message) error {
+		return nil
+	}))
+
+	msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+	select {
+	case event := <-events:
+		test.Equal(msg.GetID(), event.GetID())
+		test.Equal(MessageStatusComplete, event.GetStatus())
+		test.Empty(event.GetError())
+	case <-time.After(time.Second):
+		test.Fail("event not received")
+	}
+
+	test.Run("error", func() {
+		failed := make(chan Message, 1)
+		test.Require().NoError(other.EventSubscriber("test", "return", "err", func(ctx context.Context, msg Message) error {
+			failed <- msg
+			return nil
+		}))
+
+		msg, err := NewMessage("test", "return", "err", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+		select {
+		case event := <-failed:
+			test.Equal(MessageStatusError, event.GetStatus())
+			test.NotEmpty(event.GetError())
+		case <-time.After(time.Second):
+			test.Fail("event not received")
+		}
+	})
+	test.Run("durable", func() {
+		test.Require().NoError(other.Unsubscribe())
+
+		msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+		test.requireStatus(msg, MessageStatusComplete)
+
+		test.Require().NoError(other.EventSubscriber("test", "return", "nil", func(ctx context.Context, msg Message) error {
+			events <- msg
+			return nil
+		}))
+
+		select {
+		case event := <-events:
+			test.Equal(msg.GetID(), event.GetID())
+		case <-time.After(time.Second):
+			test.Fail("event not received")
+		}
+	})
+}
+
+func (test *MemoryStreamTest) TestPublishAt() {
+	test.Run("future", func() {
+		msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.PublishAt(context.Background(), msg, time.Now().Add(time.Second/5)))
+		test.requireStatus(msg, MessageStatusScheduled)
+		test.requireStatus(msg, MessageStatusComplete)
+	})
+	test.Run("past", func() {
+		msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.PublishAt(context.Background(), msg, time.Now().Add(-time.Minute)))
+		test.requireStatus(msg, MessageStatusComplete)
+	})
+}
+
+func (test *MemoryStreamTest) TestPublishTx() {
+	msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.PublishTx(context.Background(), nil, msg))
+	test.requireStatus(msg, MessageStatusCreated)
+
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+	test.requireStatus(msg, MessageStatusComplete)
+}
+
+func (test *MemoryStreamTest) TestSchedule() {
+	msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+	test.Require().Error(test.stream.Schedule(context.Background(), "invalid", "* * *", msg))
+	test.Require().Error(test.stream.Schedule(context.Background(), "", "* * * * *", msg))
+	test.Require().NoError(test.stream.Schedule(context.Background(), "every minute", "* * * * *", msg))
+}
+
+func (test *MemoryStreamTest) TestRunOrchestration() {
+	test.Run("normal orchestration", func() {
+		msg1, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 1})
+		test.Require().NoError(err)
+		msg2, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 2})
+		test.Require().NoError(err)
+		msg3, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 3})
+		test.Require().NoError(err)
+
+		orchestration := NewMessageOrchestration("test orchestration")
+		orchestration.AddStep("step1", []Message{msg1})
+		orchestration.AddStep("step2", []Message{msg2})
+		orchestration.SettFallback([]Message{msg3})
+
+		test.Require().NoError(test.stream.RunOrchestration(context.Background(), orchestration))
+
+		test.requireStatus(msg1, MessageStatusComplete)
+		test.requireStatus(msg2, MessageStatusComplete)
+		test.requireStatus(msg3, MessageStatusCanceled)
+	})
+	test.Run("error orchestration", func() {
+		msg1, err := NewMessage("test", "return", "err", TestPayload{Name: "test", Number: 1})
+		test.Require().NoError(err)
+		msg2, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 2})
+		test.Require().NoError(err)
+		msg3, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 3})
+		test.Require().NoError(err)
+
+		orchestration := NewMessageOrchestration("test orchestration")
+		orchestration.AddStep("step1", []Message{msg1})
+		orchestration.AddStep("step2", []Message{msg2})
+		orchestration.SettFallback([]Message{msg3})
+
+		test.Require().NoError(test.stream.RunOrchestration(context.Background(), orchestration))
+
+		test.requireStatus(msg1, MessageStatusError)
+		test.requireStatus(msg2, MessageStatusCanceled)
+		test.requireStatus(msg3, MessageStatusComplete)
+	})
+	test.Run("parallel branches", func() {
+		msg1, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 1})
+		test.Require().NoError(err)
+		msg2, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 2})
+		test.Require().NoError(err)
+		msg3, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 3})
+		test.Require().NoError(err)
+		msg4, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 4})
+		test.Require().NoError(err)
+
+		orchestration := NewMessageOrchestration("test orchestration")
+		test.Require().NoError(orchestration.AddDependentStep("branch1", nil, []Message{msg1}))
+		test.Require().NoError(orchestration.AddDependentStep("branch2", nil, []Message{msg2}))
+		test.Require().NoError(orchestration.AddDependentStep("join", []string{"branch1", "branch2"}, []Message{msg3}))
+		orchestration.SettFallback([]Message{msg4})
+
+		test.Require().NoError(test.stream.RunOrchestration(context.Background(), orchestration))
+
+		test.requireStatus(msg1, MessageStatusComplete)
+		test.requireStatus(msg2, MessageStatusComplete)
+		test.requireStatus(msg3, MessageStatusComplete)
+		test.requireStatus(msg4, MessageStatusCanceled)
+	})
+	test.Run("compensation", func() {
+		compensated := make(chan int, 3)
+		test.Require().NoError(test.stream.CommandSubscriber("compensate", "record", func(ctx context.Context, msg Message) error {
+			payload := TestPayload{}
+			if err := msg.ParsePayload(&payload); err != nil {
+				return err
+			}
+			compensated <- payload.Number
+			return nil
+		}))
+
+		orchestration := NewMessageOrchestration("test orchestration")
+		compensations := []Message{}
+
+		for i, method := range []string{"nil", "nil", "err"} {
+			msg, err := NewMessage("test", "return", method, TestPayload{Name: "test", Number: i})
+			test.Require().NoError(err)
+			orchestration.AddStep(fmt.Sprintf("step%d", i), []Message{msg})
+
+			compensation, err := NewMessage("test", "compensate", "record", TestPayload{Name: "test", Number: i})
+			test.Require().NoError(err)
+			test.Require().NoError(orchestration.AddCompensation(fmt.Sprintf("step%d", i), []Message{compensation}))
+			compensations = append(compensations, compensation)
+		}
+
+		fallback, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 3})
+		test.Require().NoError(err)
+		orchestration.SettFallback([]Message{fallback})
+
+		test.Require().NoError(test.stream.RunOrchestration(context.Background(), orchestration))
+
+		test.requireStatus(fallback, MessageStatusComplete)
+		test.requireStatus(compensations[0], MessageStatusComplete)
+		test.requireStatus(compensations[1], MessageStatusComplete)
+		test.requireStatus(compensations[2], MessageStatusCanceled)
+		test.Equal(1, <-compensated)
+		test.Equal(0, <-compensated)
+	})
+	test.Run("missing fallback", func() {
+		orchestration := NewMessageOrchestration("test orchestration")
+		test.Require().Error(test.stream.RunOrchestration(context.Background(), orchestration))
+	})
+}
+
+func (test *MemoryStreamTest) TestPurge() {
+	janitor := NewMemoryJanitor(test.bus, RetentionPolicy{Completed: time.Hour, Failed: time.Hour * 24})
+
+	completed, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), completed))
+	test.requireStatus(completed, MessageStatusComplete)
+
+	failed, err := NewMessage("test", "return", "err", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), failed))
+	test.requireStatus(failed, MessageStatusError)
+
+	created, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.PublishTx(context.Background(), nil, created))
+
+	result, err := janitor.Purge(context.Background(), time.Now())
+	test.Require().NoError(err)
+	test.Equal(int64(0), result.Messages)
+
+	result, err = janitor.Purge(context.Background(), time.Now().Add(time.Hour*2))
+	test.Require().NoError(err)
+	test.Equal(int64(1), result.Messages)
+
+	_, err = test.bus.getMessage(completed.GetID())
+	test.Error(err)
+	_, err = test.bus.getMessage(failed.GetID())
+	test.NoError(err)
+
+	result, err = janitor.Purge(context.Background(), time.Now().Add(time.Hour*48))
+	test.Require().NoError(err)
+	test.Equal(int64(1), result.Messages)
+
+	_, err = test.bus.getMessage(created.GetID())
+	test.NoError(err)
+
+	test.Run("orchestration", func() {
+		msg1, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 1})
+		test.Require().NoError(err)
+		msg2, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 2})
+		test.Require().NoError(err)
+
+		orchestration := NewMessageOrchestration("test orchestration")
+		orchestration.AddStep("step1", []Message{msg1})
+		orchestration.SettFallback([]Message{msg2})
+
+		test.Require().NoError(test.stream.RunOrchestration(context.Background(), orchestration))
+		test.requireStatus(msg2, MessageStatusCanceled)
+
+		result, err := janitor.Purge(context.Background(), time.Now().Add(time.Hour*2))
+		test.Require().NoError(err)
+		test.Equal(1, result.Orchestrations)
+		test.Equal(int64(2), result.Messages)
+	})
+}
@@ -4,54 +4,105 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type Message interface {
 	GetID() string
 	GetOrchestrationID() string
 	GetOrchestrationStep() string
+	GetSchemaVersion() int
+	GetStatus() MessageStatus
+	GetError() string
 	RawPayload() []byte
 	ParsePayload(payload interface{}) error
 	Call(ctx context.Context, key Dependency) (interface{}, error)
 	MustGet(key Dependency) interface{}
 }
 
+// defaultSchemaVersion is the payload version of messages that are not created from a
+// versioned command, and of messages stored before payloads were versioned.
+const defaultSchemaVersion = 1
+
 func NewMessage(module, component, method string, entity any) (Message, error) {
-	payload, err := json.Marshal(entity)
+	payload, err := marshalPayload(entity)
 	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %w", err)
+		return nil, err
 	}
 
 	return &message{
-		Module:    module,
-		Component: component,
-		Method:    method,
-		Payload:   payload,
+		Module:        module,
+		Component:     component,
+		Method:        method,
+		Payload:       payload,
+		SchemaVersion: defaultSchemaVersion,
 	}, nil
 }
 
+// marshalPayload uses the protobuf JSON mapping for protobuf messages, which keeps the
+// payload readable by clients in other languages and tolerant to added fields.
+func marshalPayload(entity any) ([]byte, error) {
+	var payload []byte
+
+	var err error
+
+	if pbMsg, isProto := entity.(proto.Message); isProto {
+		payload, err = protojson.Marshal(pbMsg)
+	} else {
+		payload, err = json.Marshal(entity)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %w", err)
+	}
+
+	return payload, nil
+}
+
+func unmarshalPayload(payload []byte, v interface{}) error {
+	var err error
+
+	if pbMsg, isProto := v.(proto.Message); isProto {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(payload, pbMsg)
+	} else {
+		err = json.Unmarshal(payload, v)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error unmarshalling payload: %w", err)
+	}
+
+	return nil
+}
+
 type messageStatus struct {
 	Status     MessageStatus
 	Error      *string
+	Attempt    int
 	OccurredAt time.Time
 }
 
 type message struct {
-	ID                        *string
-	OrchestrationName         *string
-	OrchestrationID           *string
-	OrchestrationStep         *string
-	OrchestrationStepNumber   *int
-	OrchestrationFallbackStep *bool
+	ID                         *string
+	OrchestrationName          *string
+	OrchestrationID            *string
+	OrchestrationStep          *string
+	OrchestrationStepNumber    *int
+	OrchestrationStepDependsOn []string
+	OrchestrationFallbackStep  *bool
 
 	Module              string
 	Component           string
 	Method              string
 	Error               *string
 	Payload             []byte
+	SchemaVersion       int
+	Attempts            int
 	StatusHistory       []messageStatus
 	dependencyContainer *dependencyContainer
 }
@@ -80,16 +131,64 @@ func (m *message) GetOrchestrationStep() string {
 	return *m.OrchestrationStep
 }
 
+func (m *message) GetSchemaVersion() int {
+	if m.SchemaVersion == 0 {
+		return defaultSchemaVersion
+	}
+
+	return m.SchemaVersion
+}
+
+// GetStatus is the latest status of the message, for an event it is the status the
+// command finished with.
+func (m *message) GetStatus() MessageStatus {
+	if len(m.StatusHistory) == 0 {
+		return ""
+	}
+
+	return m.StatusHistory[0].Status
+}
+
+// GetError is the error of the last failed attempt.
+func (m *message) GetError() string {
+	if m.Error == nil {
+		return ""
+	}
+
+	return *m.Error
+}
+
+// setStatus records a status change on the message itself, the stored status is updated
+// separately.
+func (m *message) setStatus(status MessageStatus, err error) {
+	if m.StatusHistory != nil && m.StatusHistory[0].Status == status {
+		return
+	}
+
+	var errMsg *string
+
+	if err != nil {
+		e := err.Error()
+		errMsg = &e
+		m.Error = errMsg
+	} else if status == MessageStatusCreated {
+		m.Error = nil
+	}
+
+	m.StatusHistory = append([]messageStatus{{
+		Status:     status,
+		Error:      errMsg,
+		Attempt:    m.Attempts,
+		OccurredAt: time.Now(),
+	}}, m.StatusHistory...)
+}
+
 func (m *message) RawPayload() []byte {
 	return m.Payload
 }
 
 func (m *message) ParsePayload(v interface{}) error {
-	if err := json.Unmarshal(m.Payload, v); err != nil {
-		return fmt.Errorf("error unmarshalling payload: %w", err)
-	}
-
-	return nil
+	return unmarshalPayload(m.Payload, v)
 }
 
 func (m *message) Call(ctx context.Context, key Dependency) (interface{}, error) {
@@ -113,25 +212,40 @@ func (m *message) MustGet(key Dependency) interface{} {
 type DependencyContainer interface {
 	AddMethod(key Dependency, f func(context.Context, Message) (interface{}, error))
 	AddSingleton(key Dependency, v interface{})
+	GetSingleton(key Dependency) (interface{}, bool)
+	HasMethod(key Dependency) bool
 }
 
 type dependencyContainer struct {
-	methods    map[Dependency]func(context.Context, Message) (interface{}, error)
-	singeltons map[Dependency]interface{}
+	methods     map[Dependency]func(context.Context, Message) (interface{}, error)
+	methodTypes map[Dependency]reflect.Type
+	singeltons  map[Dependency]interface{}
 }
 
 func (d *dependencyContainer) AddMethod(key Dependency, f func(context.Context, Message) (interface{}, error)) {
 	d.methods[key] = f
+	delete(d.methodTypes, key)
 }
 
 func (d *dependencyContainer) AddSingleton(key Dependency, v interface{}) {
 	d.singeltons[key] = v
 }
 
+func (d *dependencyContainer) GetSingleton(key Dependency) (interface{}, bool) {
+	v, ok := d.singeltons[key]
+	return v, ok
+}
+
+func (d *dependencyContainer) HasMethod(key Dependency) bool {
+	_, ok := d.methods[key]
+	return ok
+}
+
 func NewDependencyContainer() DependencyContainer {
 	return &dependencyContainer{
-		methods:    make(map[Dependency]func(context.Context, Message) (interface{}, error)),
-		singeltons: make(map[Dependency]interface{}),
+		methods:     make(map[Dependency]func(context.Context, Message) (interface{}, error)),
+		methodTypes: make(map[Dependency]reflect.Type),
+		singeltons:  make(map[Dependency]interface{}),
 	}
 }
 
@@ -151,15 +265,56 @@ type MessageOrchestration struct {
 }
 
 func (mo *MessageOrchestration) AddStep(name string, commands []Message) {
+	dependsOn := []string{}
+	if len(mo.Steps) > 0 {
+		dependsOn = append(dependsOn, mo.Steps[len(mo.Steps)-1].Name)
+	}
+
+	mo.addStep(name, dependsOn, commands)
+}
+
+// AddDependentStep adds a step that is started once every step in dependsOn is
+// complete, steps without dependencies are started together with the orchestration.
+// Dependencies must already be part of the orchestration which keeps it acyclic.
+func (mo *MessageOrchestration) AddDependentStep(name string, dependsOn []string, commands []Message) error {
+	if name == "fallback" {
+		return fmt.Errorf("step name %s is reserved", name)
+	}
+
+	if mo.getStep(name) != nil {
+		return fmt.Errorf("step %s already exists", name)
+	}
+
+	for _, dependency := range dependsOn {
+		if mo.getStep(dependency) == nil {
+			return fmt.Errorf("step %s depends on unknown step %s", name, dependency)
+		}
+	}
+
+	mo.addStep(name, dependsOn, commands)
+
+	return nil
+}
+
+func (mo *MessageOrchestration) addStep(name string, dependsOn []string, commands []Message) {
+	stepNumber := 0
+
+	for _, dependency := range dependsOn {
+		if parent := mo.getStep(dependency); parent != nil && parent.StepNumber >= stepNumber {
+			stepNumber = parent.StepNumber + 1
+		}
+	}
+
 	step := MessageOrchestrationStep{
 		OrchestrationName: mo.Name,
 		OrchestrationID:   mo.OrchestrationID,
 		OrchestrationStep: name,
 		Name:              name,
+		StepNumber:        stepNumber,
+		DependsOn:         dependsOn,
 		Commands:          commands,
 	}
 	fallbackStep := false
-	stepNumber := len(mo.Steps)
 
 	for _, cmd := range step.Commands {
 		msg := cmd.(*message)
@@ -167,12 +322,23 @@ func (mo *MessageOrchestration) AddStep(name string, commands []Message) {
 		msg.OrchestrationName = &step.OrchestrationName
 		msg.OrchestrationStep = &step.OrchestrationStep
 		msg.OrchestrationStepNumber = &stepNumber
+		msg.OrchestrationStepDependsOn = dependsOn
 		msg.OrchestrationFallbackStep = &fallbackStep
 	}
 
 	mo.Steps = append(mo.Steps, step)
 }
 
+func (mo *MessageOrchestration) getStep(name string) *MessageOrchestrationStep {
+	for i := range mo.Steps {
+		if mo.Steps[i].Name == name {
+			return &mo.Steps[i]
+		}
+	}
+
+	return nil
+}
+
 func (mo *MessageOrchestration) SettFallback(commands []Message) {
 	step := MessageOrchestrationStep{
 		OrchestrationName: mo.Name,
@@ -194,8 +360,59 @@ func (mo *MessageOrchestration) SettFallback(commands []Message) {
 	mo.FallbackStep = &step
 }
 
+// AddCompensation sets the commands that undo a step. When the orchestration fails the
+// compensations of every completed step are run in reverse step order, before the fallback.
+func (mo *MessageOrchestration) AddCompensation(name string, commands []Message) error {
+	step := mo.getStep(name)
+	if step == nil {
+		return fmt.Errorf("step %s does not exist", name)
+	}
+
+	if step.Compensation != nil {
+		return fmt.Errorf("step %s already has a compensation", name)
+	}
+
+	compensation := MessageOrchestrationStep{
+		OrchestrationName: mo.Name,
+		OrchestrationID:   mo.OrchestrationID,
+		OrchestrationStep: name,
+		Name:              name,
+		StepNumber:        step.StepNumber,
+		Commands:          commands,
+	}
+	fallbackStep := true
+
+	for _, cmd := range compensation.Commands {
+		msg := cmd.(*message)
+		msg.OrchestrationID = &compensation.OrchestrationID
+		msg.OrchestrationName = &compensation.OrchestrationName
+		msg.OrchestrationStep = &compensation.OrchestrationStep
+		msg.OrchestrationStepNumber = &compensation.StepNumber
+		msg.OrchestrationFallbackStep = &fallbackStep
+	}
+
+	step.Compensation = &compensation
+
+	return nil
+}
+
+func (mo *MessageOrchestration) compensations() []MessageOrchestrationStep {
+	compensations := []MessageOrchestrationStep{}
+
+	for _, step := range mo.Steps {
+		if step.Compensation != nil {
+			compensations = append(compensations, *step.Compensation)
+		}
+	}
+
+	return compensations
+}
+
 type MessageOrchestrationStep struct {
-	Name string
+	Name         string
+	StepNumber   int
+	DependsOn    []string
+	Compensation *MessageOrchestrationStep
 
 	OrchestrationID   string
 	OrchestrationName string
@@ -160,6 +160,38 @@ func (test *MessageOrchestrationTest) TestNewMessageOrchestration() {
 		test.Equal(0, *orchestration.Steps[0].Commands[0].(*message).OrchestrationStepNumber)
 		test.False(*orchestration.Steps[0].Commands[0].(*message).OrchestrationFallbackStep)
 	})
+	test.Run("add dependent steps", func() {
+		orchestration := NewMessageOrchestration("test orchestration")
+
+		m1, err := NewMessage("module", "component", "method", DemoEntity{Key: "key", Value: 1})
+		test.Require().NoError(err)
+		m2, err := NewMessage("module", "component", "method", DemoEntity{Key: "key", Value: 2})
+		test.Require().NoError(err)
+		m3, err := NewMessage("module", "component", "method", DemoEntity{Key: "key", Value: 3})
+		test.Require().NoError(err)
+
+		test.Require().NoError(orchestration.AddDependentStep("branch a", nil, []Message{m1}))
+		test.Require().NoError(orchestration.AddDependentStep("branch b", nil, []Message{m2}))
+		test.Require().NoError(orchestration.AddDependentStep("join", []string{"branch a", "branch b"}, []Message{m3}))
+		test.Len(orchestration.Steps, 3)
+		test.Equal(0, *m1.(*message).OrchestrationStepNumber)
+		test.Equal(0, *m2.(*message).OrchestrationStepNumber)
+		test.Equal(1, *m3.(*message).OrchestrationStepNumber)
+		test.Equal([]string{"branch a", "branch b"}, m3.(*message).OrchestrationStepDependsOn)
+
+		test.Require().Error(orchestration.AddDependentStep("join", nil, []Message{}))
+		test.Require().Error(orchestration.AddDependentStep("unknown", []string{"missing"}, []Message{}))
+		test.Require().Error(orchestration.AddDependentStep("fallback", nil, []Message{}))
+	})
+	test.Run("add step depends on previous", func() {
+		orchestration := NewMessageOrchestration("test orchestration")
+
+		orchestration.AddStep("first", []Message{})
+		orchestration.AddStep("second", []Message{})
+		test.Empty(orchestration.Steps[0].DependsOn)
+		test.Equal([]string{"first"}, orchestration.Steps[1].DependsOn)
+		test.Equal(1, orchestration.Steps[1].StepNumber)
+	})
 	test.Run("set fallback", func() {
 		orchestration := NewMessageOrchestration("test")
 		test.NotNil(orchestration)
@@ -179,4 +211,25 @@ func (test *MessageOrchestrationTest) TestNewMessageOrchestration() {
 		test.NotEmpty(orchestration.FallbackStep.Commands[0].(*message).OrchestrationName)
 		test.Equal("fallback", *orchestration.FallbackStep.Commands[0].(*message).OrchestrationStep)
 	})
+	test.Run("add compensation", func() {
+		orchestration := NewMessageOrchestration("test")
+
+		orchestration.AddStep("first", []Message{})
+		orchestration.AddStep("second", []Message{})
+
+		m, err := NewMessage("module", "component", "method", DemoEntity{Key: "key", Value: 1})
+		test.Require().NoError(err)
+
+		test.Require().NoError(orchestration.AddCompensation("second", []Message{m}))
+		test.Require().Error(orchestration.AddCompensation("second", []Message{}))
+		test.Require().Error(orchestration.AddCompensation("missing", []Message{}))
+
+		test.Nil(orchestration.Steps[0].Compensation)
+		test.Require().NotNil(orchestration.Steps[1].Compensation)
+		test.Equal("second", *m.(*message).OrchestrationStep)
+		test.Equal(1, *m.(*message).OrchestrationStepNumber)
+		test.True(*m.(*message).OrchestrationFallbackStep)
+		test.True(isCompensation(m.(*message)))
+		test.Len(orchestration.compensations(), 1)
+	})
 }
@@ -23,6 +23,54 @@ func (_m *MockDependencyContainer) AddSingleton(key Dependency, v interface{}) {
 	_m.Called(key, v)
 }
 
+// GetSingleton provides a mock function with given fields: key
+func (_m *MockDependencyContainer) GetSingleton(key Dependency) (interface{}, bool) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSingleton")
+	}
+
+	var r0 interface{}
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(Dependency) (interface{}, bool)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(Dependency) interface{}); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(Dependency) bool); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// HasMethod provides a mock function with given fields: key
+func (_m *MockDependencyContainer) HasMethod(key Dependency) bool {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasMethod")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(Dependency) bool); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // NewMockDependencyContainer creates a new instance of MockDependencyContainer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockDependencyContainer(t interface {
@@ -43,6 +43,24 @@ func (_m *MockMessage) Call(ctx context.Context, key Dependency) (interface{}, e
 	return r0, r1
 }
 
+// GetError provides a mock function with given fields:
+func (_m *MockMessage) GetError() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetError")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GetID provides a mock function with given fields:
 func (_m *MockMessage) GetID() string {
 	ret := _m.Called()
@@ -97,6 +115,42 @@ func (_m *MockMessage) GetOrchestrationStep() string {
 	return r0
 }
 
+// GetSchemaVersion provides a mock function with given fields:
+func (_m *MockMessage) GetSchemaVersion() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchemaVersion")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetStatus provides a mock function with given fields:
+func (_m *MockMessage) GetStatus() MessageStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 MessageStatus
+	if rf, ok := ret.Get(0).(func() MessageStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(MessageStatus)
+	}
+
+	return r0
+}
+
 // MustGet provides a mock function with given fields: key
 func (_m *MockMessage) MustGet(key Dependency) interface{} {
 	ret := _m.Called(key)
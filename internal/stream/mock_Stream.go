@@ -4,7 +4,9 @@ package stream
 
 import (
 	context "context"
+	time "time"
 
+	pgx "github.com/jackc/pgx/v5"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -13,17 +15,42 @@ type MockStream struct {
 	mock.Mock
 }
 
-// CommandSubscriber provides a mock function with given fields: component, method, cb
-func (_m *MockStream) CommandSubscriber(component string, method string, cb func(context.Context, Message) error) error {
-	ret := _m.Called(component, method, cb)
+// CommandSubscriber provides a mock function with given fields: component, method, cb, options
+func (_m *MockStream) CommandSubscriber(component string, method string, cb func(context.Context, Message) error, options ...SubscriberOption) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, component, method, cb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CommandSubscriber")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, func(context.Context, Message) error) error); ok {
-		r0 = rf(component, method, cb)
+	if rf, ok := ret.Get(0).(func(string, string, func(context.Context, Message) error, ...SubscriberOption) error); ok {
+		r0 = rf(component, method, cb, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EventSubscriber provides a mock function with given fields: module, component, method, cb
+func (_m *MockStream) EventSubscriber(module string, component string, method string, cb func(context.Context, Message) error) error {
+	ret := _m.Called(module, component, method, cb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EventSubscriber")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, func(context.Context, Message) error) error); ok {
+		r0 = rf(module, component, method, cb)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,6 +76,42 @@ func (_m *MockStream) Publish(ctx context.Context, message Message) error {
 	return r0
 }
 
+// PublishAt provides a mock function with given fields: ctx, message, at
+func (_m *MockStream) PublishAt(ctx context.Context, message Message, at time.Time) error {
+	ret := _m.Called(ctx, message, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, Message, time.Time) error); ok {
+		r0 = rf(ctx, message, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PublishTx provides a mock function with given fields: ctx, tx, message
+func (_m *MockStream) PublishTx(ctx context.Context, tx pgx.Tx, message Message) error {
+	ret := _m.Called(ctx, tx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx, Message) error); ok {
+		r0 = rf(ctx, tx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RunOrchestration provides a mock function with given fields: ctx, orchestration
 func (_m *MockStream) RunOrchestration(ctx context.Context, orchestration *MessageOrchestration) error {
 	ret := _m.Called(ctx, orchestration)
@@ -67,6 +130,24 @@ func (_m *MockStream) RunOrchestration(ctx context.Context, orchestration *Messa
 	return r0
 }
 
+// Schedule provides a mock function with given fields: ctx, name, spec, message
+func (_m *MockStream) Schedule(ctx context.Context, name string, spec string, message Message) error {
+	ret := _m.Called(ctx, name, spec, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, Message) error); ok {
+		r0 = rf(ctx, name, spec, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Unsubscribe provides a mock function with given fields:
 func (_m *MockStream) Unsubscribe() error {
 	ret := _m.Called()
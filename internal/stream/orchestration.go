@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lhjnilsson/foreverbull/internal/environment"
@@ -12,6 +13,25 @@ import (
 	"go.uber.org/fx"
 )
 
+type OrchestrationStatus string
+
+const (
+	OrchestrationStatusCreated   OrchestrationStatus = "CREATED"
+	OrchestrationStatusRunning   OrchestrationStatus = "RUNNING"
+	OrchestrationStatusCompleted OrchestrationStatus = "COMPLETED"
+	OrchestrationStatusFailed    OrchestrationStatus = "FAILED"
+	OrchestrationStatusCanceled  OrchestrationStatus = "CANCELED"
+)
+
+type orchestration struct {
+	ID        string
+	Name      string
+	Status    OrchestrationStatus
+	Active    bool
+	CreatedAt time.Time
+	Messages  []message
+}
+
 type OrchestrationOutput struct {
 	orchestrations []*MessageOrchestration
 }
@@ -34,16 +54,146 @@ func (po *OrchestrationOutput) Contains(name string) bool {
 	return false
 }
 
-func NewOrchestrationRunner(stream *NATSStream) (*OrchestrationRunner, error) {
+func newOrchestrationStream(jetstream nats.JetStreamContext, pool *pgxpool.Pool) *NATSStream {
+	return &NATSStream{
+		module:     "orchestration",
+		jt:         jetstream,
+		repository: NewRepository(pool),
+		deps:       NewDependencyContainer().(*dependencyContainer),
+		limit:      newSemaphore(environment.GetStreamConcurrency("orchestration")),
+		retries:    newRetryTimers(),
+		running:    newRunningCommands(),
+	}
+}
+
+const (
+	// scheduleInterval is how often the runner looks for due schedules, scheduled messages
+	// and retries.
+	scheduleInterval = 5 * time.Second
+	// relayInterval is how often the runner relays created messages that are not on the
+	// stream, relayGracePeriod gives Publish time to publish new messages itself. The relay
+	// only publishes messages whose publisher failed or stopped.
+	relayInterval    = time.Second
+	relayGracePeriod = 5 * time.Second
+	// electionInterval is how often a replica tries to become leader, and how often the
+	// leader checks that it still is.
+	electionInterval = 5 * time.Second
+)
+
+func NewOrchestrationRunner(stream *NATSStream, pool *pgxpool.Pool) (*OrchestrationRunner, error) {
 	return &OrchestrationRunner{
-		stream: stream,
+		stream:           stream,
+		lock:             newLeaderLock(pool, orchestrationLockID),
+		scheduleInterval: scheduleInterval,
+		relayInterval:    relayInterval,
+		relayGracePeriod: relayGracePeriod,
+		electionInterval: electionInterval,
 	}, nil
 }
 
+// OrchestrationRunner advances orchestrations as their commands finish. Every replica
+// runs one, but only the replica holding the leader lock consumes events and publishes
+// scheduled and relayed messages.
 type OrchestrationRunner struct {
 	stream *NATSStream
+	lock   *leaderLock
 
-	sub *nats.Subscription
+	scheduleInterval time.Duration
+	relayInterval    time.Duration
+	relayGracePeriod time.Duration
+	electionInterval time.Duration
+
+	stop context.CancelFunc
+	done chan struct{}
+}
+
+// orchestrationDurable is the consumer of command events shared by all replicas.
+const orchestrationDurable = "foreverbull-orchestration-event"
+
+func (or *OrchestrationRunner) run(ctx context.Context) {
+	defer close(or.done)
+
+	ticker := time.NewTicker(or.electionInterval)
+	defer ticker.Stop()
+
+	for {
+		leader, err := or.lock.tryAcquire(ctx)
+		if err != nil {
+			log.Err(err).Msg("error taking orchestration leader lock")
+		}
+
+		if leader {
+			log.Info().Msg("leading orchestrations")
+
+			if err := or.lead(ctx); err != nil {
+				log.Err(err).Msg("error leading orchestrations")
+			}
+
+			or.lock.release()
+			log.Info().Msg("stopped leading orchestrations")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead handles events, scheduled messages and the relay until ctx is done or the leader
+// lock is lost.
+func (or *OrchestrationRunner) lead(ctx context.Context) error {
+	sub, err := or.stream.jt.PullSubscribe("foreverbull.*.*.*.event", orchestrationDurable,
+		nats.Bind(streamName, orchestrationDurable))
+	if err != nil {
+		return fmt.Errorf("error subscribing to jetstream for orchestration: %w", err)
+	}
+
+	defer sub.Unsubscribe() //nolint: errcheck
+
+	go or.stream.consume(sub, newSemaphore(1), func(natsMsg *nats.Msg) error {
+		or.msgHandler(natsMsg)
+		return nil
+	})
+
+	schedule := time.NewTicker(or.scheduleInterval)
+	defer schedule.Stop()
+
+	relay := time.NewTicker(or.relayInterval)
+	defer relay.Stop()
+
+	election := time.NewTicker(or.electionInterval)
+	defer election.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-schedule.C:
+			if err := or.stream.publishDue(ctx, time.Now()); err != nil {
+				log.Err(err).Msg("error publishing scheduled messages")
+			}
+		case <-relay.C:
+			relayed, err := or.stream.relayCreated(ctx, time.Now().Add(-or.relayGracePeriod))
+			if err != nil {
+				log.Err(err).Msg("error relaying created messages")
+			} else if relayed > 0 {
+				log.Info().Int("relayed", relayed).Msg("relayed created messages")
+			}
+
+			continued, err := or.stream.relayOrchestrations(ctx, time.Now().Add(-or.relayGracePeriod))
+			if err != nil {
+				log.Err(err).Msg("error relaying orchestrations")
+			} else if continued > 0 {
+				log.Info().Int("orchestrations", continued).Msg("relayed stalled orchestrations")
+			}
+		case <-election.C:
+			if err := or.lock.check(ctx); err != nil {
+				return fmt.Errorf("lost orchestration leader lock: %w", err)
+			}
+		}
+	}
 }
 
 func (or *OrchestrationRunner) msgHandler(natsMsg *nats.Msg) {
@@ -92,18 +242,21 @@ func (or *OrchestrationRunner) msgHandler(natsMsg *nats.Msg) {
 		return
 	}
 
-	commands, err := or.stream.repository.GetNextOrchestrationCommands(ctx, *msg.OrchestrationID, *msg.OrchestrationStepNumber)
+	commands, err := or.stream.repository.GetNextOrchestrationCommands(ctx, *msg.OrchestrationID)
 	if err != nil {
 		log.Err(err).Msg("error getting next orchestration commands")
 		return
 	}
 
-	if commands == nil {
+	if len(*commands) == 0 {
 		log.Debug().Msg("no commands to run")
 		return
 	}
 
-	if len(*commands) > 0 && (*commands)[0].OrchestrationFallbackStep != nil && *(*commands)[0].OrchestrationFallbackStep {
+	switch {
+	case isCompensation(&(*commands)[0]):
+		log.Debug().Msg("orchestration is compensating")
+	case isFallback(&(*commands)[0]):
 		log.Debug().Msg("orchestration is failing")
 
 		defer func() {
@@ -114,66 +267,58 @@ func (or *OrchestrationRunner) msgHandler(natsMsg *nats.Msg) {
 		}()
 	}
 
-	for _, cmd := range *commands {
-		log.Info().Str("CmdID", *cmd.ID).Str("CmdComponent", cmd.Component).Str("CmdMethod", cmd.Method).Msg("publishing command")
+	log.Info().Int("commands", len(*commands)).Msg("publishing commands")
 
-		err = or.stream.Publish(ctx, &cmd)
-		if err != nil {
-			log.Err(err).Msg("error publishing command")
-			return
-		}
+	err = or.stream.publishOrchestrationCommands(ctx, commands)
+	if err != nil {
+		log.Err(err).Msg("error publishing command")
+		return
 	}
 }
 
 func (or *OrchestrationRunner) Start() error {
-	var err error
-
-	opts := []nats.SubOpt{
-		nats.MaxDeliver(1),
-		nats.Durable("foreverbull-orchestration-event"),
+	if or.stream == nil {
+		return nil
 	}
-	deliverPolicy := environment.GetNATSDeliveryPolicy()
 
-	switch deliverPolicy {
-	case "all":
-		opts = append(opts, nats.DeliverAll())
-	case "last":
-		opts = append(opts, nats.DeliverLast())
-	default:
-		return fmt.Errorf("unknown delivery policy: %s", environment.GetNATSDeliveryPolicy())
-	}
-
-	or.sub, err = or.stream.jt.Subscribe("foreverbull.*.*.*.event", or.msgHandler, opts...)
+	// The consumer is created up front so no event is missed while electing a leader
+	err := or.stream.ensurePullConsumer("foreverbull.*.*.*.event", orchestrationDurable, 1, 1)
 	if err != nil {
-		return fmt.Errorf("error subscribing to jetstream for orchestration: %w", err)
+		return err
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	or.stop = cancel
+	or.done = make(chan struct{})
+
+	go or.run(ctx)
+
 	return nil
 }
 
 func (or *OrchestrationRunner) Stop() error {
-	if err := or.sub.Unsubscribe(); err != nil {
-		return fmt.Errorf("error unsubscribing from jetstream for orchestration: %w", err)
+	if or.stop == nil {
+		return nil
 	}
 
+	or.stop()
+	<-or.done
+
 	return nil
 }
 
 var OrchestrationLifecycle = fx.Options( //nolint: gochecknoglobals
 	fx.Provide(
 		func(jetstream nats.JetStreamContext, pool *pgxpool.Pool) (*OrchestrationRunner, error) {
-			cfg := nats.ConsumerConfig{
-				Name:       "orchestration-event",
-				Durable:    "orchestration-event",
-				MaxDeliver: 1,
+			if environment.GetStreamBackend() == MemoryBackend {
+				// Orchestrations are driven by the memory bus itself
+				return &OrchestrationRunner{}, nil
 			}
-			_, err := jetstream.AddConsumer("foreverbull", &cfg)
-			if err != nil {
-				return nil, fmt.Errorf("error adding consumer for orchestration: %w", err)
+			// Earlier versions created an unused consumer for orchestration events
+			if err := deleteLegacyConsumer(jetstream, "orchestration-event"); err != nil {
+				return nil, err
 			}
-			dc := NewDependencyContainer().(*dependencyContainer)
-			stream := &NATSStream{module: "orchestration", jt: jetstream, repository: NewRepository(pool), deps: dc}
-			return NewOrchestrationRunner(stream)
+			return NewOrchestrationRunner(newOrchestrationStream(jetstream, pool), pool)
 		},
 	),
 	fx.Invoke(
@@ -2,9 +2,13 @@ package stream
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/postgres"
 )
 
 type MessageStatus string
@@ -16,6 +20,8 @@ const (
 	MessageStatusComplete  MessageStatus = "COMPLETE"
 	MessageStatusError     MessageStatus = "ERROR"
 	MessageStatusCanceled  MessageStatus = "CANCELED"
+	MessageStatusRetry     MessageStatus = "RETRY"
+	MessageStatusScheduled MessageStatus = "SCHEDULED"
 )
 
 func CreateTables(ctx context.Context, conn *pgxpool.Pool) error {
@@ -32,6 +38,14 @@ func CreateTables(ctx context.Context, conn *pgxpool.Pool) error {
 }
 
 func RecreateTables(ctx context.Context, conn *pgxpool.Pool) error {
+	if _, err := conn.Exec(ctx, `DROP TABLE IF EXISTS message_schedule;`); err != nil {
+		return fmt.Errorf("failed to drop table: %w", err)
+	}
+
+	if _, err := conn.Exec(ctx, `DROP TABLE IF EXISTS message_dead_letter;`); err != nil {
+		return fmt.Errorf("failed to drop table: %w", err)
+	}
+
 	if _, err := conn.Exec(ctx, `DROP TABLE IF EXISTS message_status;`); err != nil {
 		return fmt.Errorf("failed to drop table: %w", err)
 	}
@@ -61,32 +75,65 @@ CREATE TABLE IF NOT EXISTS message (
 	orchestration_id text,
 	orchestration_step text,
 	orchestration_step_number integer,
+	orchestration_step_depends_on text[],
 	orchestration_fallback_step boolean,
 
 	module text NOT NULL,
 	component text NOT NULL,
 	method text NOT NULL,
 	payload JSONB,
+	schema_version integer NOT NULL DEFAULT 1,
 
 	status text NOT NULL DEFAULT 'CREATED',
 	error text,
+	attempts integer NOT NULL DEFAULT 0,
 
 	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW());
 
+ALTER TABLE message ADD COLUMN IF NOT EXISTS attempts integer NOT NULL DEFAULT 0;
+ALTER TABLE message ADD COLUMN IF NOT EXISTS orchestration_step_depends_on text[];
+ALTER TABLE message ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+ALTER TABLE message ADD COLUMN IF NOT EXISTS schema_version integer NOT NULL DEFAULT 1;
+
 CREATE TABLE IF NOT EXISTS message_status (
 	id serial PRIMARY KEY,
 	message_id text REFERENCES message(id) ON DELETE CASCADE,
 	status text NOT NULL,
 	error text,
+	attempt integer NOT NULL DEFAULT 0,
 	occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
+ALTER TABLE message_status ADD COLUMN IF NOT EXISTS attempt integer NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS message_dead_letter (
+	message_id text PRIMARY KEY REFERENCES message(id) ON DELETE CASCADE,
+	attempts integer NOT NULL,
+	error text,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS message_schedule (
+	name text PRIMARY KEY,
+	cron text NOT NULL,
+	module text NOT NULL,
+	component text NOT NULL,
+	method text NOT NULL,
+	payload JSONB,
+	schema_version integer NOT NULL DEFAULT 1,
+	next_run_at TIMESTAMPTZ NOT NULL,
+	last_run_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE message_schedule ADD COLUMN IF NOT EXISTS schema_version integer NOT NULL DEFAULT 1;
+
 CREATE OR REPLACE FUNCTION notify_message_status() RETURNS TRIGGER AS $$
 BEGIN
 	-- Only update message_status if the status column is updated
 	IF (TG_OP = 'UPDATE' AND OLD.status <> NEW.status) OR TG_OP = 'INSERT' THEN
-		INSERT INTO message_status (message_id, status, error)
-		VALUES (NEW.id, NEW.status, NEW.error);
+		INSERT INTO message_status (message_id, status, error, attempt)
+		VALUES (NEW.id, NEW.status, NEW.error, NEW.attempts);
 		PERFORM pg_notify('message_status', NEW.id);
 	END IF;
 	RETURN NEW;
@@ -103,21 +150,33 @@ EXCEPTION
 END$$;
 `
 
+// dbtx is satisfied by both the pool and a transaction, so the repository can take part
+// in a transaction started by the caller.
+type dbtx interface {
+	postgres.Query
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 type repository struct {
-	db *pgxpool.Pool
+	db dbtx
 }
 
 func NewRepository(db *pgxpool.Pool) repository {
 	return repository{db: db}
 }
 
+func (r *repository) withTx(tx pgx.Tx) repository {
+	return repository{db: tx}
+}
+
 func (r *repository) CreateMessage(ctx context.Context, msg *message) error {
 	err := r.db.QueryRow(ctx,
 		`INSERT INTO message (orchestration_name, orchestration_id, orchestration_step, orchestration_step_number,
-			orchestration_fallback_step, module, component, method, payload)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`, msg.OrchestrationName,
-		msg.OrchestrationID, msg.OrchestrationStep, msg.OrchestrationStepNumber, msg.OrchestrationFallbackStep,
-		msg.Module, msg.Component, msg.Method, msg.Payload).Scan(&msg.ID)
+			orchestration_step_depends_on, orchestration_fallback_step, module, component, method, payload, schema_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id`, msg.OrchestrationName,
+		msg.OrchestrationID, msg.OrchestrationStep, msg.OrchestrationStepNumber, msg.OrchestrationStepDependsOn,
+		msg.OrchestrationFallbackStep,
+		msg.Module, msg.Component, msg.Method, msg.Payload, msg.GetSchemaVersion()).Scan(&msg.ID)
 	if err != nil {
 		return fmt.Errorf("failed to insert message: %w", err)
 	}
@@ -125,15 +184,56 @@ func (r *repository) CreateMessage(ctx context.Context, msg *message) error {
 	return nil
 }
 
+func (r *repository) CreateScheduledMessage(ctx context.Context, msg *message, publishAt time.Time) error {
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO message (module, component, method, payload, schema_version, status, publish_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+		msg.Module, msg.Component, msg.Method, msg.Payload, msg.GetSchemaVersion(), MessageStatusScheduled, publishAt).Scan(&msg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to insert scheduled message: %w", err)
+	}
+
+	return nil
+}
+
+// ListDueMessages returns scheduled messages, or messages waiting for a retry, that should
+// be published at or before the given time, oldest first.
+func (r *repository) ListDueMessages(ctx context.Context, status MessageStatus, now time.Time) (*[]message, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id, module, component, method, payload, schema_version FROM message
+		WHERE status=$1 AND publish_at <= $2 ORDER BY publish_at`, status, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query due messages: %w", err)
+	}
+
+	defer rows.Close()
+
+	msgs := []message{}
+
+	for rows.Next() {
+		msg := message{}
+
+		err = rows.Scan(&msg.ID, &msg.Module, &msg.Component, &msg.Method, &msg.Payload, &msg.SchemaVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan message: %w", err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return &msgs, nil
+}
+
 func (r *repository) GetMessage(ctx context.Context, messageID string) (*message, error) {
 	msg := message{}
 
 	rows, err := r.db.Query(ctx,
-		`SELECT message.id, orchestration_name, orchestration_id, orchestration_step, orchestration_step_number, orchestration_fallback_step,
-		module, component, method, payload, ms.status, ms.error, ms.occurred_at
+		`SELECT message.id, orchestration_name, orchestration_id, orchestration_step, orchestration_step_number,
+		orchestration_step_depends_on, orchestration_fallback_step, module, component, method, payload, schema_version, attempts,
+		ms.status, ms.error, ms.attempt, ms.occurred_at
 		FROM message
 		INNER JOIN (
-			SELECT message_id, status, error, occurred_at FROM message_status ORDER BY occurred_at DESC
+			SELECT message_id, status, error, attempt, occurred_at FROM message_status ORDER BY occurred_at DESC
 		)	AS ms ON message.id=ms.message_id
 		WHERE message.id=$1`, messageID)
 	if err != nil {
@@ -146,8 +246,8 @@ func (r *repository) GetMessage(ctx context.Context, messageID string) (*message
 		status := messageStatus{}
 
 		err := rows.Scan(&msg.ID, &msg.OrchestrationName, &msg.OrchestrationID, &msg.OrchestrationStep, &msg.OrchestrationStepNumber,
-			&msg.OrchestrationFallbackStep, &msg.Module, &msg.Component, &msg.Method, &msg.Payload,
-			&status.Status, &status.Error, &status.OccurredAt)
+			&msg.OrchestrationStepDependsOn, &msg.OrchestrationFallbackStep, &msg.Module, &msg.Component, &msg.Method, &msg.Payload,
+			&msg.SchemaVersion, &msg.Attempts, &status.Status, &status.Error, &status.Attempt, &status.OccurredAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan message: %w", err)
 		}
@@ -155,6 +255,10 @@ func (r *repository) GetMessage(ctx context.Context, messageID string) (*message
 		msg.StatusHistory = append(msg.StatusHistory, status)
 	}
 
+	if msg.ID == nil {
+		return nil, fmt.Errorf("failed to get message: %w", pgx.ErrNoRows)
+	}
+
 	return &msg, nil
 }
 
@@ -162,8 +266,8 @@ func (r *repository) UpdatePublishedAndGetMessage(ctx context.Context, messageID
 	var msgID *string
 
 	err := r.db.QueryRow(ctx,
-		`UPDATE message SET status=$1 WHERE id=$2 AND status=$3
-		RETURNING id`, MessageStatusReceived, messageID, MessageStatusPublished).Scan(&msgID)
+		`UPDATE message SET status=$1, attempts=attempts+1 WHERE id=$2 AND status IN ($3, $4)
+		RETURNING id`, MessageStatusReceived, messageID, MessageStatusPublished, MessageStatusCreated).Scan(&msgID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update message: %w", err)
 	}
@@ -189,6 +293,196 @@ func (r *repository) UpdateMessageStatus(ctx context.Context, messageID string,
 	return nil
 }
 
+// UpdateMessageRetry stores when the message is retried, so that a retry survives a restart.
+func (r *repository) UpdateMessageRetry(ctx context.Context, messageID string, err error, retryAt time.Time) error {
+	_, err = r.db.Exec(ctx,
+		`UPDATE message SET status=$1, error=$2, publish_at=$3 WHERE id=$4`,
+		MessageStatusRetry, err.Error(), retryAt, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to update message retry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) CreateDeadLetter(ctx context.Context, messageID string, attempts int, err error) error {
+	_, err = r.db.Exec(ctx,
+		`INSERT INTO message_dead_letter (message_id, attempts, error) VALUES ($1, $2, $3)
+		ON CONFLICT (message_id) DO UPDATE SET attempts=EXCLUDED.attempts, error=EXCLUDED.error, created_at=NOW()`,
+		messageID, attempts, err.Error())
+	if err != nil {
+		return fmt.Errorf("failed to create dead letter: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) ListDeadLetters(ctx context.Context) (*[]message, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT message.id, orchestration_name, orchestration_id, orchestration_step, orchestration_step_number,
+		orchestration_fallback_step, module, component, method, payload, message_dead_letter.attempts, message_dead_letter.error
+		FROM message_dead_letter
+		INNER JOIN message ON message.id=message_dead_letter.message_id
+		ORDER BY message_dead_letter.created_at ASC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dead letters: %w", err)
+	}
+
+	defer rows.Close()
+
+	msgs := []message{}
+
+	for rows.Next() {
+		msg := message{}
+
+		err := rows.Scan(&msg.ID, &msg.OrchestrationName, &msg.OrchestrationID, &msg.OrchestrationStep, &msg.OrchestrationStepNumber,
+			&msg.OrchestrationFallbackStep, &msg.Module, &msg.Component, &msg.Method, &msg.Payload, &msg.Attempts, &msg.Error)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan dead letter: %w", err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return &msgs, nil
+}
+
+// RedriveDeadLetter removes the message from the dead letter table and resets it to
+// created, making it ready to be published again.
+func (r *repository) RedriveDeadLetter(ctx context.Context, messageID string) (*message, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) //nolint: errcheck
+
+	tag, err := tx.Exec(ctx, `DELETE FROM message_dead_letter WHERE message_id=$1`, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete dead letter: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return nil, fmt.Errorf("failed to find dead letter: %w", pgx.ErrNoRows)
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE message SET status=$1, error=NULL, attempts=0 WHERE id=$2`,
+		MessageStatusCreated, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset message: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return r.GetMessage(ctx, messageID)
+}
+
+type messageFilter struct {
+	Status    *MessageStatus
+	Module    *string
+	Component *string
+	Method    *string
+}
+
+// ListMessages returns the newest messages matching the filter, each with its current status.
+func (r *repository) ListMessages(ctx context.Context, filter messageFilter, limit int) (*[]message, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT message.id, orchestration_name, orchestration_id, orchestration_step, orchestration_step_number,
+		orchestration_step_depends_on, orchestration_fallback_step, module, component, method, payload, schema_version,
+		attempts, message.status, message.error, ms.occurred_at
+		FROM message
+		INNER JOIN LATERAL (
+			SELECT occurred_at FROM message_status WHERE message_status.message_id=message.id
+			ORDER BY occurred_at DESC LIMIT 1
+		) AS ms ON true
+		WHERE ($1::text IS NULL OR message.status=$1) AND ($2::text IS NULL OR module=$2)
+		AND ($3::text IS NULL OR component=$3) AND ($4::text IS NULL OR method=$4)
+		ORDER BY created_at DESC LIMIT $5`, filter.Status, filter.Module, filter.Component, filter.Method, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query messages: %w", err)
+	}
+
+	defer rows.Close()
+
+	msgs := []message{}
+
+	for rows.Next() {
+		msg := message{}
+		status := messageStatus{}
+
+		err = rows.Scan(&msg.ID, &msg.OrchestrationName, &msg.OrchestrationID, &msg.OrchestrationStep, &msg.OrchestrationStepNumber,
+			&msg.OrchestrationStepDependsOn, &msg.OrchestrationFallbackStep, &msg.Module, &msg.Component, &msg.Method, &msg.Payload,
+			&msg.SchemaVersion, &msg.Attempts, &status.Status, &status.Error, &status.OccurredAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan message: %w", err)
+		}
+
+		status.Attempt = msg.Attempts
+		msg.StatusHistory = []messageStatus{status}
+		msgs = append(msgs, msg)
+	}
+
+	return &msgs, nil
+}
+
+var ErrMessageActive = errors.New("message is active")
+
+// ResetMessage makes a finished message ready to be published again. For orchestration
+// commands the canceled commands are reset and the fallback step and compensations are
+// armed again, so the orchestration continues once the command completes.
+func (r *repository) ResetMessage(ctx context.Context, messageID string) (*message, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) //nolint: errcheck
+
+	var status MessageStatus
+
+	var orchestrationID *string
+
+	err = tx.QueryRow(ctx, `SELECT status, orchestration_id FROM message WHERE id=$1 FOR UPDATE`, messageID).Scan(
+		&status, &orchestrationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if status != MessageStatusComplete && status != MessageStatusError && status != MessageStatusCanceled {
+		return nil, ErrMessageActive
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE message SET status=$1, error=NULL, attempts=0 WHERE id=$2`,
+		MessageStatusCreated, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset message: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM message_dead_letter WHERE message_id=$1`, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete dead letter: %w", err)
+	}
+
+	if orchestrationID != nil {
+		_, err = tx.Exec(ctx,
+			`UPDATE message SET status=$1, error=NULL, attempts=0 WHERE orchestration_id=$2 AND (
+				(orchestration_fallback_step=false AND status=$3) OR (orchestration_fallback_step=true AND status<>$1)
+			)`, MessageStatusCreated, *orchestrationID, MessageStatusCanceled)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reset orchestration: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return r.GetMessage(ctx, messageID)
+}
+
 func (r *repository) OrchestrationIsRunning(ctx context.Context, orchestrationID string) (bool, error) {
 	var total int
 
@@ -229,10 +523,9 @@ func (r *repository) OrchestrationIsComplete(ctx context.Context, orchestrationI
 
 	err := r.db.QueryRow(ctx,
 		`SELECT COUNT(*) FROM message WHERE message.orchestration_id=$1
-		AND message.status IN ($2, $3, $4, $5)
+		AND message.status<>$2
 		AND message.orchestration_fallback_step=false`,
-		orchestrationID, MessageStatusCreated, MessageStatusPublished, MessageStatusError,
-		MessageStatusReceived).Scan(&count)
+		orchestrationID, MessageStatusComplete).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf("failed to query orchestration status: %w", err)
 	}
@@ -240,42 +533,58 @@ func (r *repository) OrchestrationIsComplete(ctx context.Context, orchestrationI
 	return !(count > 0), nil
 }
 
-func (r *repository) GetNextOrchestrationCommands(ctx context.Context, orchestrationID string, currentStepNumber int) (*[]message, error) {
-	var stepComplete bool
-
-	err := r.db.QueryRow(ctx,
-		`SELECT count(*) = count(*) filter (where status IN ($1, $2)) FROM message WHERE orchestration_id=$3 AND orchestration_step_number=$4`,
-		MessageStatusComplete, MessageStatusError, orchestrationID, currentStepNumber).Scan(&stepComplete)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query orchestration status: %w", err)
-	}
-
-	var msgs []message
-	if !stepComplete {
-		return &msgs, nil
-	}
-
+// GetNextOrchestrationCommands returns created commands whose dependency steps are all complete.
+// Once a command has failed or been canceled and nothing else is in flight the compensations of
+// completed steps are returned, one step number at a time starting with the highest, followed by
+// the fallback commands.
+func (r *repository) GetNextOrchestrationCommands(ctx context.Context, orchestrationID string) (*[]message, error) {
 	rows, err := r.db.Query(ctx, `
 WITH orchestration AS (
-	SELECT id, orchestration_id, orchestration_step, orchestration_step_number, orchestration_fallback_step, status, module, component, method, payload FROM message WHERE message.orchestration_id=$1
-) SELECT id, orchestration_id, orchestration_step, orchestration_fallback_step, module, component, method, payload FROM orchestration WHERE
+	SELECT id, orchestration_name, orchestration_id, orchestration_step, orchestration_step_number, orchestration_step_depends_on,
+	orchestration_fallback_step, status, module, component, method, payload, created_at FROM message WHERE message.orchestration_id=$1
+), state AS (
+	SELECT EXISTS(SELECT 1 FROM orchestration WHERE status=$2 OR (orchestration_fallback_step=false AND status=$8)) AS failed,
+	EXISTS(SELECT 1 FROM orchestration WHERE status IN ($3, $4, $5)) AS in_flight
+), compensation AS (
+	SELECT id, orchestration_step_number FROM orchestration WHERE orchestration_fallback_step=true
+	AND orchestration_step_number IS NOT NULL AND status=$6 AND NOT EXISTS(
+		SELECT 1 FROM orchestration cmd WHERE cmd.orchestration_fallback_step=false
+		AND cmd.orchestration_step=orchestration.orchestration_step AND cmd.status<>$7
+	)
+) SELECT id, orchestration_name, orchestration_id, orchestration_step, orchestration_step_number, orchestration_step_depends_on,
+orchestration_fallback_step, module, component, method, payload FROM orchestration, state WHERE orchestration.status=$6 AND
 CASE
-    WHEN (SELECT EXISTS(SELECT 1 FROM orchestration WHERE status = $2)) THEN
-        orchestration_fallback_step=true
-    ELSE
-        orchestration_fallback_step=false and orchestration.orchestration_step_number=$3 and orchestration.status=$4
-END`, orchestrationID, MessageStatusError, currentStepNumber+1, MessageStatusCreated)
+	WHEN state.failed THEN
+		NOT state.in_flight AND orchestration_fallback_step=true AND CASE
+			WHEN EXISTS(SELECT 1 FROM compensation) THEN
+				id IN (SELECT id FROM compensation) AND
+				orchestration_step_number=(SELECT MAX(orchestration_step_number) FROM compensation)
+			ELSE
+				orchestration_step_number IS NULL
+		END
+	ELSE
+		orchestration_fallback_step=false AND NOT EXISTS(
+			SELECT 1 FROM orchestration parent WHERE parent.status<>$7 AND parent.orchestration_fallback_step=false AND (
+				parent.orchestration_step=ANY(orchestration.orchestration_step_depends_on) OR
+				(orchestration.orchestration_step_depends_on IS NULL AND parent.orchestration_step_number=orchestration.orchestration_step_number-1)
+			)
+		)
+END
+ORDER BY orchestration_step_number, created_at`, orchestrationID, MessageStatusError, MessageStatusPublished, MessageStatusReceived,
+		MessageStatusRetry, MessageStatusCreated, MessageStatusComplete, MessageStatusCanceled)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query orchestration commands: %w", err)
 	}
 
 	defer rows.Close()
 
+	msgs := []message{}
+
 	for rows.Next() {
 		msg := message{}
 
-		err := rows.Scan(&msg.ID, &msg.OrchestrationID, &msg.OrchestrationStep, &msg.OrchestrationFallbackStep,
-			&msg.Module, &msg.Component, &msg.Method, &msg.Payload)
+		err := rows.Scan(&msg.ID, &msg.OrchestrationName, &msg.OrchestrationID, &msg.OrchestrationStep, &msg.OrchestrationStepNumber,
+			&msg.OrchestrationStepDependsOn, &msg.OrchestrationFallbackStep, &msg.Module, &msg.Component, &msg.Method, &msg.Payload)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan orchestration commands: %w", err)
 		}
@@ -286,6 +595,151 @@ END`, orchestrationID, MessageStatusError, currentStepNumber+1, MessageStatusCre
 	return &msgs, nil
 }
 
+// ClaimAndPublish locks the message while it is in the given status and publishes it, the
+// message is only moved to published once publish has returned. Concurrent publishers skip
+// the locked message and a message that failed to publish keeps its status. It returns false
+// when the message is no longer in that status.
+func (r *repository) ClaimAndPublish(ctx context.Context, messageID string, from MessageStatus,
+	publish func() error,
+) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) //nolint: errcheck
+
+	var id string
+
+	err = tx.QueryRow(ctx, `SELECT id FROM message WHERE id=$1 AND status=$2 FOR UPDATE SKIP LOCKED`,
+		messageID, from).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to claim message: %w", err)
+	}
+
+	if err = publish(); err != nil {
+		return false, fmt.Errorf("failed to publish message %s: %w", messageID, err)
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE message SET status=$1 WHERE id=$2`, MessageStatusPublished, messageID)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark message as published: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// MarkPublished moves a created message to published once it is on the stream, messages
+// that were already received or claimed are left as they are.
+func (r *repository) MarkPublished(ctx context.Context, messageID string) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE message SET status=$1 WHERE id=$2 AND status=$3`,
+		MessageStatusPublished, messageID, MessageStatusCreated)
+	if err != nil {
+		return fmt.Errorf("failed to mark message as published: %w", err)
+	}
+
+	return nil
+}
+
+// RelayCreated publishes messages outside of orchestrations that were created before the
+// given time but never made it to the stream. Messages are locked while published so
+// that concurrent relays skip them.
+func (r *repository) RelayCreated(ctx context.Context, createdBefore time.Time, limit int,
+	publish func(*message) error,
+) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) //nolint: errcheck
+
+	rows, err := tx.Query(ctx,
+		`SELECT id, module, component, method, payload, attempts FROM message
+		WHERE status=$1 AND orchestration_id IS NULL AND created_at < $2
+		ORDER BY created_at LIMIT $3 FOR UPDATE SKIP LOCKED`, MessageStatusCreated, createdBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query created messages: %w", err)
+	}
+
+	msgs := []message{}
+
+	for rows.Next() {
+		msg := message{}
+
+		err = rows.Scan(&msg.ID, &msg.Module, &msg.Component, &msg.Method, &msg.Payload, &msg.Attempts)
+		if err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan message: %w", err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	rows.Close()
+
+	for _, msg := range msgs {
+		if err = publish(&msg); err != nil {
+			return 0, fmt.Errorf("failed to publish message %s: %w", *msg.ID, err)
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE message SET status=$1 WHERE id=$2`, MessageStatusPublished, msg.ID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to mark message as published: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(msgs), nil
+}
+
+// ListStalledOrchestrations returns orchestrations that have created commands but nothing in
+// flight, and where the last status change is before the given time.
+func (r *repository) ListStalledOrchestrations(ctx context.Context, before time.Time, limit int) (*[]string, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT m.orchestration_id FROM message m
+		LEFT JOIN (
+			SELECT message_id, max(occurred_at) AS occurred_at FROM message_status GROUP BY message_id
+		) AS ms ON ms.message_id=m.id
+		WHERE m.orchestration_id IS NOT NULL
+		GROUP BY m.orchestration_id
+		HAVING bool_or(m.status=$1) AND NOT bool_or(m.status IN ($2, $3, $4))
+		AND max(COALESCE(ms.occurred_at, m.created_at)) < $5
+		LIMIT $6`,
+		MessageStatusCreated, MessageStatusPublished, MessageStatusReceived, MessageStatusRetry, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stalled orchestrations: %w", err)
+	}
+
+	defer rows.Close()
+
+	orchestrations := []string{}
+
+	for rows.Next() {
+		var orchestrationID string
+
+		if err = rows.Scan(&orchestrationID); err != nil {
+			return nil, fmt.Errorf("failed to scan stalled orchestration: %w", err)
+		}
+
+		orchestrations = append(orchestrations, orchestrationID)
+	}
+
+	return &orchestrations, nil
+}
+
 func (r *repository) OrchestrationStepIsComplete(ctx context.Context, orchestrationID, step string) (bool, error) {
 	var count int
 
@@ -323,3 +777,363 @@ func (r *repository) MarkAllCreatedAsCanceled(ctx context.Context, orchestration
 
 	return nil
 }
+
+const orchestrationSummary = `SELECT orchestration_id, orchestration_name, MIN(created_at),
+CASE
+	WHEN COUNT(*) FILTER (WHERE status=$1) > 0 THEN $2
+	WHEN COUNT(*) FILTER (WHERE orchestration_fallback_step=false AND status=$12) > 0 THEN $13
+	WHEN COUNT(*) FILTER (WHERE orchestration_fallback_step=false AND status<>$3) = 0 THEN $4
+	WHEN COUNT(*) FILTER (WHERE status<>$5) = 0 THEN $6
+	ELSE $7
+END,
+COUNT(*) FILTER (WHERE status IN ($5, $8, $9, $10)) > 0
+FROM message`
+
+func (r *repository) orchestrationSummaryArgs() []any {
+	return []any{
+		MessageStatusError, OrchestrationStatusFailed,
+		MessageStatusComplete, OrchestrationStatusCompleted,
+		MessageStatusCreated, OrchestrationStatusCreated,
+		OrchestrationStatusRunning,
+		MessageStatusPublished, MessageStatusReceived, MessageStatusRetry,
+	}
+}
+
+func (r *repository) orchestrationSummaryCanceledArgs() []any {
+	return []any{MessageStatusCanceled, OrchestrationStatusCanceled}
+}
+
+func (r *repository) ListOrchestrations(ctx context.Context, name *string) (*[]orchestration, error) {
+	args := append(append(r.orchestrationSummaryArgs(), name), r.orchestrationSummaryCanceledArgs()...)
+
+	rows, err := r.db.Query(ctx, orchestrationSummary+`
+		WHERE orchestration_id IS NOT NULL AND ($11::text IS NULL OR orchestration_name=$11)
+		GROUP BY orchestration_id, orchestration_name
+		ORDER BY MIN(created_at) DESC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orchestrations: %w", err)
+	}
+
+	defer rows.Close()
+
+	orchestrations := []orchestration{}
+
+	for rows.Next() {
+		o := orchestration{}
+
+		err = rows.Scan(&o.ID, &o.Name, &o.CreatedAt, &o.Status, &o.Active)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan orchestration: %w", err)
+		}
+
+		orchestrations = append(orchestrations, o)
+	}
+
+	return &orchestrations, nil
+}
+
+func (r *repository) GetOrchestrationSummary(ctx context.Context, orchestrationID string) (*orchestration, error) {
+	args := append(append(r.orchestrationSummaryArgs(), orchestrationID), r.orchestrationSummaryCanceledArgs()...)
+
+	o := orchestration{}
+
+	err := r.db.QueryRow(ctx, orchestrationSummary+`
+		WHERE orchestration_id=$11
+		GROUP BY orchestration_id, orchestration_name`, args...).Scan(&o.ID, &o.Name, &o.CreatedAt, &o.Status, &o.Active)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orchestration: %w", err)
+	}
+
+	return &o, nil
+}
+
+func (r *repository) GetOrchestration(ctx context.Context, orchestrationID string) (*orchestration, error) {
+	o, err := r.GetOrchestrationSummary(ctx, orchestrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT id FROM message WHERE orchestration_id=$1
+		ORDER BY orchestration_fallback_step, orchestration_step_number, created_at`, orchestrationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orchestration messages: %w", err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan orchestration messages: %w", err)
+	}
+
+	for _, id := range ids {
+		msg, err := r.GetMessage(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		o.Messages = append(o.Messages, *msg)
+	}
+
+	return o, nil
+}
+
+var ErrOrchestrationActive = errors.New("orchestration has active commands")
+
+// ResetOrchestration makes failed and canceled commands, together with every command in
+// step when given, ready to be published again. The fallback step and compensations are armed again.
+func (r *repository) ResetOrchestration(ctx context.Context, orchestrationID string, step *string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) //nolint: errcheck
+
+	var active bool
+
+	err = tx.QueryRow(ctx,
+		`SELECT EXISTS(SELECT 1 FROM message WHERE orchestration_id=$1 AND status IN ($2, $3, $4))`,
+		orchestrationID, MessageStatusPublished, MessageStatusReceived, MessageStatusRetry).Scan(&active)
+	if err != nil {
+		return fmt.Errorf("failed to query orchestration status: %w", err)
+	}
+
+	if active {
+		return ErrOrchestrationActive
+	}
+
+	tag, err := tx.Exec(ctx,
+		`UPDATE message SET status=$1, error=NULL, attempts=0 WHERE orchestration_id=$2 AND orchestration_fallback_step=false
+		AND (status IN ($3, $4) OR orchestration_step=$5)`,
+		MessageStatusCreated, orchestrationID, MessageStatusError, MessageStatusCanceled, step)
+	if err != nil {
+		return fmt.Errorf("failed to reset orchestration: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("failed to find orchestration commands to reset: %w", pgx.ErrNoRows)
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE message SET status=$1, error=NULL, attempts=0 WHERE orchestration_id=$2 AND orchestration_fallback_step=true
+		AND status<>$1`, MessageStatusCreated, orchestrationID)
+	if err != nil {
+		return fmt.Errorf("failed to reset orchestration fallback: %w", err)
+	}
+
+	_, err = tx.Exec(ctx,
+		`DELETE FROM message_dead_letter WHERE message_id IN (
+			SELECT id FROM message WHERE orchestration_id=$1 AND status=$2
+		)`, orchestrationID, MessageStatusCreated)
+	if err != nil {
+		return fmt.Errorf("failed to delete dead letters: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CancelOrchestration cancels every command that has not started, commands that are
+// running must be canceled by their subscriber.
+func (r *repository) CancelOrchestration(ctx context.Context, orchestrationID string) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE message SET status=$1 WHERE orchestration_id=$2 AND orchestration_fallback_step=false
+		AND status IN ($3, $4, $5)`,
+		MessageStatusCanceled, orchestrationID, MessageStatusCreated, MessageStatusPublished, MessageStatusRetry)
+	if err != nil {
+		return fmt.Errorf("failed to cancel orchestration: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) UpsertSchedule(ctx context.Context, s *schedule) error {
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO message_schedule (name, cron, module, component, method, payload, schema_version, next_run_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (name) DO UPDATE SET cron=EXCLUDED.cron, module=EXCLUDED.module, component=EXCLUDED.component,
+		method=EXCLUDED.method, payload=EXCLUDED.payload, schema_version=EXCLUDED.schema_version,
+		next_run_at=EXCLUDED.next_run_at
+		RETURNING last_run_at, created_at`,
+		s.Name, s.Cron, s.Module, s.Component, s.Method, s.Payload, s.SchemaVersion, s.NextRunAt).Scan(&s.LastRunAt, &s.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) ListSchedules(ctx context.Context) (*[]schedule, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT name, cron, module, component, method, payload, schema_version, next_run_at, last_run_at, created_at
+		FROM message_schedule ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schedules: %w", err)
+	}
+
+	defer rows.Close()
+
+	schedules := []schedule{}
+
+	for rows.Next() {
+		s := schedule{}
+
+		err = rows.Scan(&s.Name, &s.Cron, &s.Module, &s.Component, &s.Method, &s.Payload, &s.SchemaVersion,
+			&s.NextRunAt, &s.LastRunAt, &s.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule: %w", err)
+		}
+
+		schedules = append(schedules, s)
+	}
+
+	return &schedules, nil
+}
+
+func (r *repository) DeleteSchedule(ctx context.Context, name string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM message_schedule WHERE name=$1`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// FireDueSchedules creates a scheduled message for every schedule that is due and moves
+// the schedule to its next run. Schedules locked by another runner are skipped.
+func (r *repository) FireDueSchedules(ctx context.Context, now time.Time) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) //nolint: errcheck
+
+	rows, err := tx.Query(ctx,
+		`SELECT name, cron, module, component, method, payload, schema_version FROM message_schedule
+		WHERE next_run_at <= $1 FOR UPDATE SKIP LOCKED`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query due schedules: %w", err)
+	}
+
+	schedules := []schedule{}
+
+	for rows.Next() {
+		s := schedule{}
+
+		err = rows.Scan(&s.Name, &s.Cron, &s.Module, &s.Component, &s.Method, &s.Payload, &s.SchemaVersion)
+		if err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan schedule: %w", err)
+		}
+
+		schedules = append(schedules, s)
+	}
+
+	rows.Close()
+
+	for _, s := range schedules {
+		cron, err := parseCron(s.Cron)
+		if err != nil {
+			return 0, fmt.Errorf("error parsing cron of schedule %s: %w", s.Name, err)
+		}
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO message (module, component, method, payload, schema_version, status, publish_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			s.Module, s.Component, s.Method, s.Payload, s.SchemaVersion, MessageStatusScheduled, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed to insert scheduled message: %w", err)
+		}
+
+		_, err = tx.Exec(ctx,
+			`UPDATE message_schedule SET last_run_at=$1, next_run_at=$2 WHERE name=$3`,
+			now, cron.Next(now), s.Name)
+		if err != nil {
+			return 0, fmt.Errorf("failed to update schedule: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(schedules), nil
+}
+
+// expiredMessages is a finished orchestration, or a finished message outside of one, that
+// is past its retention.
+type expiredMessages struct {
+	ID            string
+	Orchestration bool
+	Failed        bool
+}
+
+// ListExpiredMessages returns orchestrations and messages where every message has finished
+// and the last status change is before completedBefore, or failedBefore when any of the
+// messages failed.
+func (r *repository) ListExpiredMessages(ctx context.Context, completedBefore, failedBefore time.Time,
+	limit int,
+) (*[]expiredMessages, error) {
+	finished := []string{string(MessageStatusComplete), string(MessageStatusError), string(MessageStatusCanceled)}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT COALESCE(m.orchestration_id, m.id) AS group_id, m.orchestration_id IS NOT NULL AS orchestration,
+			bool_or(m.status=$1) AS failed
+		FROM message m
+		LEFT JOIN (
+			SELECT message_id, max(occurred_at) AS occurred_at FROM message_status GROUP BY message_id
+		) AS ms ON ms.message_id=m.id
+		GROUP BY group_id, orchestration
+		HAVING bool_and(m.status = ANY($2))
+		AND max(COALESCE(ms.occurred_at, m.created_at)) <
+			CASE WHEN bool_or(m.status=$1) THEN $3::timestamptz ELSE $4::timestamptz END
+		LIMIT $5`,
+		MessageStatusError, finished, failedBefore, completedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired messages: %w", err)
+	}
+
+	defer rows.Close()
+
+	expired := []expiredMessages{}
+
+	for rows.Next() {
+		e := expiredMessages{}
+
+		if err = rows.Scan(&e.ID, &e.Orchestration, &e.Failed); err != nil {
+			return nil, fmt.Errorf("failed to scan expired messages: %w", err)
+		}
+
+		expired = append(expired, e)
+	}
+
+	return &expired, nil
+}
+
+// DeleteOrchestration removes every message of the orchestration together with the status
+// history, it returns how many messages were removed.
+func (r *repository) DeleteOrchestration(ctx context.Context, orchestrationID string) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM message WHERE orchestration_id=$1`, orchestrationID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete orchestration: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *repository) DeleteMessage(ctx context.Context, messageID string) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM message WHERE id=$1`, messageID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -2,8 +2,11 @@ package stream
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lhjnilsson/foreverbull/internal/environment"
 	"github.com/lhjnilsson/foreverbull/internal/test_helper"
@@ -54,6 +57,7 @@ func (test *RepositoryTest) TestUpdatePublishedAndGetMessage() {
 			Component:                 "test_component",
 			Method:                    "test_method",
 			Payload:                   nil,
+			SchemaVersion:             2,
 		}
 		test.Require().NoError(test.repository.CreateMessage(context.TODO(), &msg))
 		test.Require().NotNil(msg.ID)
@@ -73,7 +77,7 @@ func (test *RepositoryTest) TestUpdatePublishedAndGetMessage() {
 		},
 		{
 			Status:        MessageStatusCreated,
-			ExpectMessage: false,
+			ExpectMessage: true,
 		},
 		{
 			Status:        MessageStatusReceived,
@@ -100,6 +104,7 @@ func (test *RepositoryTest) TestUpdatePublishedAndGetMessage() {
 				test.Require().NoError(err)
 				test.NotNil(msg)
 				test.Equal(MessageStatusReceived, msg.StatusHistory[0].Status)
+				test.Equal(2, msg.SchemaVersion)
 			} else {
 				test.Require().Error(err)
 				test.Nil(msg)
@@ -160,7 +165,6 @@ func (test *RepositoryTest) TestGetNextOrchestrationCommands() {
 
 	type TestCase struct {
 		Name             string
-		CurrentStep      int
 		ExpectedMessages *[]message
 		StoredData       string
 	}
@@ -169,19 +173,27 @@ func (test *RepositoryTest) TestGetNextOrchestrationCommands() {
 		{
 			Name:       "initial",
 			StoredData: ``,
-		},
-		{
-			Name:        "current step -1",
-			CurrentStep: -1,
-			StoredData:  ``,
 			ExpectedMessages: &[]message{
-				{},
-				{},
+				{OrchestrationFallbackStep: &isFalse, Module: "service", Component: "service", Method: "start"},
+				{OrchestrationFallbackStep: &isFalse, Module: "service", Component: "service", Method: "start"},
 			},
 		},
 		{
-			Name:        "service started successfully",
-			CurrentStep: 0,
+			Name: "service starting",
+			StoredData: `
+UPDATE message SET status='PUBLISHED' WHERE orchestration_step_number=0;
+`,
+		},
+		{
+			Name: "service partially started",
+			StoredData: `
+UPDATE message SET status='PUBLISHED' WHERE orchestration_step_number=0;
+UPDATE message set status='COMPLETE' WHERE id IN (
+	SELECT id FROM message where orchestration_step_number=0 limit 1
+)`,
+		},
+		{
+			Name: "service started successfully",
 			ExpectedMessages: &[]message{
 				{OrchestrationFallbackStep: &isFalse, Module: "service", Component: "service", Method: "sanity_check"},
 			},
@@ -190,36 +202,47 @@ UPDATE message SET status='COMPLETE' WHERE orchestration_step_number=0;
 `,
 		},
 		{
-			Name:        "One start failed",
-			CurrentStep: 0,
+			Name: "One start failed while other is running",
+			StoredData: `
+UPDATE message SET status='RECEIVED' WHERE orchestration_step_number=0;
+UPDATE message set status='ERROR' WHERE id IN (
+	SELECT id FROM message where orchestration_step_number=0 limit 1
+)`,
+		},
+		{
+			Name: "One start failed",
 			StoredData: `
 UPDATE message SET status='COMPLETE' WHERE orchestration_step_number=0;
 UPDATE message set status='ERROR' WHERE id IN (
 	SELECT id FROM message where orchestration_step_number=0 limit 1
 )`,
 			ExpectedMessages: &[]message{
-				{OrchestrationFallbackStep: &isTrue},
-				{OrchestrationFallbackStep: &isTrue},
+				{OrchestrationFallbackStep: &isTrue, Module: "service", Component: "instance", Method: "stop"},
+				{OrchestrationFallbackStep: &isTrue, Module: "service", Component: "instance", Method: "stop"},
 			},
 		},
 		{
-			Name:        "Run failed",
-			CurrentStep: 2,
+			Name: "Run failed",
 			StoredData: `
 UPDATE message SET status='COMPLETE' WHERE orchestration_step_number=0;
 UPDATE message set status='COMPLETE' WHERE orchestration_step_number=1;
 UPDATE message set status='ERROR' WHERE orchestration_step_number=2;`,
 			ExpectedMessages: &[]message{
-				{OrchestrationFallbackStep: &isTrue},
-				{OrchestrationFallbackStep: &isTrue},
+				{OrchestrationFallbackStep: &isTrue, Module: "service", Component: "instance", Method: "stop"},
+				{OrchestrationFallbackStep: &isTrue, Module: "service", Component: "instance", Method: "stop"},
 			},
 		},
 		{
-			Name:        "All steps succeeded",
-			CurrentStep: 3,
+			Name: "Fallback already published",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step_number=0;
+UPDATE message set status='ERROR' WHERE orchestration_step_number=1;
+UPDATE message set status='PUBLISHED' WHERE orchestration_fallback_step=true;`,
+		},
+		{
+			Name: "All steps succeeded",
 			StoredData: `
 		UPDATE message SET status='COMPLETE' WHERE orchestration_fallback_step=false;`,
-			ExpectedMessages: &[]message{},
 		},
 	}
 	for _, testCase := range testCases {
@@ -229,20 +252,376 @@ UPDATE message set status='ERROR' WHERE orchestration_step_number=2;`,
 			_, err := test.db.Exec(context.TODO(), testCase.StoredData)
 			test.Require().NoError(err)
 
-			commands, err := test.repository.GetNextOrchestrationCommands(context.TODO(),
-				baseOrchestration.OrchestrationID, testCase.CurrentStep)
+			commands, err := test.repository.GetNextOrchestrationCommands(context.TODO(), baseOrchestration.OrchestrationID)
 			test.Require().NoError(err)
 
 			if testCase.ExpectedMessages == nil {
 				test.Empty(*commands)
 			} else {
 				test.Require().NotNil(commands)
-				test.Equal(len(*testCase.ExpectedMessages), len(*commands))
+				test.Require().Len(*commands, len(*testCase.ExpectedMessages))
+				for i, expected := range *testCase.ExpectedMessages {
+					test.Equal(*expected.OrchestrationFallbackStep, *(*commands)[i].OrchestrationFallbackStep)
+					test.Equal(expected.Module, (*commands)[i].Module)
+					test.Equal(expected.Component, (*commands)[i].Component)
+					test.Equal(expected.Method, (*commands)[i].Method)
+				}
+			}
+		})
+	}
+}
+
+func (test *RepositoryTest) TestGetNextOrchestrationCommandsDAG() {
+	createOrchestration := func() *MessageOrchestration {
+		orchestration := NewMessageOrchestration("repository_test")
+
+		for _, name := range []string{"branch_a", "branch_b"} {
+			msg, err := NewMessage("finance", "marketdata", "ingest", nil)
+			test.Require().NoError(err)
+			test.Require().NoError(orchestration.AddDependentStep(name, nil, []Message{msg}))
+		}
+
+		msg, err := NewMessage("backtest", "ingest", "ingest", nil)
+		test.Require().NoError(err)
+		test.Require().NoError(orchestration.AddDependentStep("join", []string{"branch_a", "branch_b"}, []Message{msg}))
+
+		msg, err = NewMessage("backtest", "status", "update", nil)
+		test.Require().NoError(err)
+		orchestration.SettFallback([]Message{msg})
+
+		for _, step := range orchestration.Steps {
+			for _, cmd := range step.Commands {
+				test.Require().NoError(test.repository.CreateMessage(context.TODO(), cmd.(*message)))
+			}
+		}
+
+		for _, cmd := range orchestration.FallbackStep.Commands {
+			test.Require().NoError(test.repository.CreateMessage(context.TODO(), cmd.(*message)))
+		}
+
+		return orchestration
+	}
+
+	type TestCase struct {
+		Name          string
+		StoredData    string
+		ExpectedSteps []string
+	}
+
+	testCases := []TestCase{
+		{
+			Name:          "initial",
+			ExpectedSteps: []string{"branch_a", "branch_b"},
+		},
+		{
+			Name: "one branch complete",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step='branch_a';
+UPDATE message SET status='RECEIVED' WHERE orchestration_step='branch_b';`,
+		},
+		{
+			Name: "one branch complete other not started",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step='branch_a';`,
+			ExpectedSteps: []string{"branch_b"},
+		},
+		{
+			Name: "all branches complete",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step IN ('branch_a', 'branch_b');`,
+			ExpectedSteps: []string{"join"},
+		},
+		{
+			Name: "one branch failed",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step='branch_a';
+UPDATE message SET status='ERROR' WHERE orchestration_step='branch_b';`,
+			ExpectedSteps: []string{"fallback"},
+		},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.Name, func() {
+			orchestration := createOrchestration()
+
+			_, err := test.db.Exec(context.TODO(), testCase.StoredData)
+			test.Require().NoError(err)
+
+			commands, err := test.repository.GetNextOrchestrationCommands(context.TODO(), orchestration.OrchestrationID)
+			test.Require().NoError(err)
+
+			steps := []string{}
+			for _, cmd := range *commands {
+				steps = append(steps, *cmd.OrchestrationStep)
 			}
+			test.ElementsMatch(testCase.ExpectedSteps, steps)
 		})
 	}
 }
 
+func (test *RepositoryTest) TestGetNextOrchestrationCommandsCompensation() {
+	createOrchestration := func() *MessageOrchestration {
+		orchestration := NewMessageOrchestration("repository_test")
+
+		for _, name := range []string{"branch_a", "branch_b"} {
+			msg, err := NewMessage("finance", "marketdata", "ingest", nil)
+			test.Require().NoError(err)
+			test.Require().NoError(orchestration.AddDependentStep(name, nil, []Message{msg}))
+		}
+
+		msg, err := NewMessage("backtest", "ingest", "ingest", nil)
+		test.Require().NoError(err)
+		test.Require().NoError(orchestration.AddDependentStep("join", []string{"branch_a", "branch_b"}, []Message{msg}))
+
+		for _, name := range []string{"branch_a", "branch_b", "join"} {
+			msg, err := NewMessage("finance", "marketdata", "delete", nil)
+			test.Require().NoError(err)
+			test.Require().NoError(orchestration.AddCompensation(name, []Message{msg}))
+		}
+
+		msg, err = NewMessage("backtest", "status", "update", nil)
+		test.Require().NoError(err)
+		orchestration.SettFallback([]Message{msg})
+
+		for _, step := range append(orchestration.Steps, orchestration.compensations()...) {
+			for _, cmd := range step.Commands {
+				test.Require().NoError(test.repository.CreateMessage(context.TODO(), cmd.(*message)))
+			}
+		}
+
+		for _, cmd := range orchestration.FallbackStep.Commands {
+			test.Require().NoError(test.repository.CreateMessage(context.TODO(), cmd.(*message)))
+		}
+
+		return orchestration
+	}
+
+	type TestCase struct {
+		Name                 string
+		StoredData           string
+		ExpectedSteps        []string
+		ExpectedCompensation bool
+	}
+
+	testCases := []TestCase{
+		{
+			Name:          "initial",
+			ExpectedSteps: []string{"branch_a", "branch_b"},
+		},
+		{
+			Name: "join failed",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step IN ('branch_a', 'branch_b') AND orchestration_fallback_step=false;
+UPDATE message SET status='ERROR' WHERE orchestration_step='join' AND orchestration_fallback_step=false;`,
+			ExpectedSteps:        []string{"branch_a", "branch_b"},
+			ExpectedCompensation: true,
+		},
+		{
+			Name: "branch failed",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step='branch_a' AND orchestration_fallback_step=false;
+UPDATE message SET status='ERROR' WHERE orchestration_step='branch_b' AND orchestration_fallback_step=false;`,
+			ExpectedSteps:        []string{"branch_a"},
+			ExpectedCompensation: true,
+		},
+		{
+			Name: "compensation running",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step='branch_a' AND orchestration_fallback_step=false;
+UPDATE message SET status='ERROR' WHERE orchestration_step='branch_b' AND orchestration_fallback_step=false;
+UPDATE message SET status='RECEIVED' WHERE orchestration_step='branch_a' AND orchestration_fallback_step=true;`,
+		},
+		{
+			Name: "compensation complete",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step='branch_a' AND orchestration_fallback_step=false;
+UPDATE message SET status='ERROR' WHERE orchestration_step='branch_b' AND orchestration_fallback_step=false;
+UPDATE message SET status='COMPLETE' WHERE orchestration_step='branch_a' AND orchestration_fallback_step=true;`,
+			ExpectedSteps: []string{"fallback"},
+		},
+		{
+			Name: "compensation failed",
+			StoredData: `
+UPDATE message SET status='COMPLETE' WHERE orchestration_step='branch_a' AND orchestration_fallback_step=false;
+UPDATE message SET status='ERROR' WHERE orchestration_step='branch_b' AND orchestration_fallback_step=false;
+UPDATE message SET status='ERROR' WHERE orchestration_step='branch_a' AND orchestration_fallback_step=true;`,
+			ExpectedSteps: []string{"fallback"},
+		},
+	}
+	for _, testCase := range testCases {
+		test.Run(testCase.Name, func() {
+			orchestration := createOrchestration()
+
+			_, err := test.db.Exec(context.TODO(), testCase.StoredData)
+			test.Require().NoError(err)
+
+			commands, err := test.repository.GetNextOrchestrationCommands(context.TODO(), orchestration.OrchestrationID)
+			test.Require().NoError(err)
+
+			steps := []string{}
+			for _, cmd := range *commands {
+				steps = append(steps, *cmd.OrchestrationStep)
+				test.Equal(testCase.ExpectedCompensation, isCompensation(&cmd))
+			}
+			test.ElementsMatch(testCase.ExpectedSteps, steps)
+		})
+	}
+}
+
+func (test *RepositoryTest) TestClaimAndPublish() {
+	msg := message{Module: "test_module", Component: "test_component", Method: "test_method"}
+	test.Require().NoError(test.repository.CreateMessage(context.TODO(), &msg))
+
+	published := func() error { return nil }
+
+	test.Run("publish failed", func() {
+		claimed, err := test.repository.ClaimAndPublish(context.TODO(), *msg.ID, MessageStatusCreated, func() error {
+			return errors.New("nats down")
+		})
+		test.Require().Error(err)
+		test.False(claimed)
+
+		stored, err := test.repository.GetMessage(context.TODO(), *msg.ID)
+		test.Require().NoError(err)
+		test.Equal(MessageStatusCreated, stored.StatusHistory[0].Status)
+	})
+	test.Run("concurrent", func() {
+		claimed, err := test.repository.ClaimAndPublish(context.TODO(), *msg.ID, MessageStatusCreated, func() error {
+			stored, err := test.repository.GetMessage(context.TODO(), *msg.ID)
+			test.Require().NoError(err)
+			test.Equal(MessageStatusCreated, stored.StatusHistory[0].Status)
+
+			claimed, err := test.repository.ClaimAndPublish(context.TODO(), *msg.ID, MessageStatusCreated, published)
+			test.Require().NoError(err)
+			test.False(claimed)
+
+			return nil
+		})
+		test.Require().NoError(err)
+		test.True(claimed)
+
+		stored, err := test.repository.GetMessage(context.TODO(), *msg.ID)
+		test.Require().NoError(err)
+		test.Equal(MessageStatusPublished, stored.StatusHistory[0].Status)
+	})
+	test.Run("already claimed", func() {
+		claimed, err := test.repository.ClaimAndPublish(context.TODO(), *msg.ID, MessageStatusCreated, published)
+		test.Require().NoError(err)
+		test.False(claimed)
+	})
+	test.Run("retry", func() {
+		test.Require().NoError(test.repository.UpdateMessageRetry(context.TODO(), *msg.ID, errors.New("failed"), time.Now()))
+		claimed, err := test.repository.ClaimAndPublish(context.TODO(), *msg.ID, MessageStatusRetry, published)
+		test.Require().NoError(err)
+		test.True(claimed)
+	})
+}
+
+func (test *RepositoryTest) TestListStalledOrchestrations() {
+	orchestration := NewMessageOrchestration("stalled")
+	step1, err := NewMessage("test_module", "test_component", "test_method", nil)
+	test.Require().NoError(err)
+	orchestration.AddStep("step1", []Message{step1})
+	step2, err := NewMessage("test_module", "test_component", "test_method", nil)
+	test.Require().NoError(err)
+	orchestration.AddStep("step2", []Message{step2})
+
+	for _, msg := range []Message{step1, step2} {
+		test.Require().NoError(test.repository.CreateMessage(context.TODO(), msg.(*message)))
+	}
+
+	stalled, err := test.repository.ListStalledOrchestrations(context.TODO(), time.Now(), 10)
+	test.Require().NoError(err)
+	test.Equal([]string{orchestration.OrchestrationID}, *stalled)
+
+	stalled, err = test.repository.ListStalledOrchestrations(context.TODO(), time.Now().Add(-time.Hour), 10)
+	test.Require().NoError(err)
+	test.Empty(*stalled)
+
+	test.Require().NoError(test.repository.UpdateMessageStatus(context.TODO(), step1.GetID(), MessageStatusPublished, nil))
+	stalled, err = test.repository.ListStalledOrchestrations(context.TODO(), time.Now(), 10)
+	test.Require().NoError(err)
+	test.Empty(*stalled)
+
+	test.Require().NoError(test.repository.UpdateMessageStatus(context.TODO(), step1.GetID(), MessageStatusComplete, nil))
+	stalled, err = test.repository.ListStalledOrchestrations(context.TODO(), time.Now(), 10)
+	test.Require().NoError(err)
+	test.Equal([]string{orchestration.OrchestrationID}, *stalled)
+
+	test.Require().NoError(test.repository.UpdateMessageStatus(context.TODO(), step2.GetID(), MessageStatusComplete, nil))
+	stalled, err = test.repository.ListStalledOrchestrations(context.TODO(), time.Now(), 10)
+	test.Require().NoError(err)
+	test.Empty(*stalled)
+}
+
+func (test *RepositoryTest) TestMarkPublished() {
+	msg := message{Module: "test_module", Component: "test_component", Method: "test_method"}
+	test.Require().NoError(test.repository.CreateMessage(context.TODO(), &msg))
+
+	test.Require().NoError(test.repository.MarkPublished(context.TODO(), *msg.ID))
+	stored, err := test.repository.GetMessage(context.TODO(), *msg.ID)
+	test.Require().NoError(err)
+	test.Equal(MessageStatusPublished, stored.StatusHistory[0].Status)
+
+	received := message{Module: "test_module", Component: "test_component", Method: "test_method"}
+	test.Require().NoError(test.repository.CreateMessage(context.TODO(), &received))
+	_, err = test.repository.UpdatePublishedAndGetMessage(context.TODO(), *received.ID)
+	test.Require().NoError(err)
+
+	test.Require().NoError(test.repository.MarkPublished(context.TODO(), *received.ID))
+	stored, err = test.repository.GetMessage(context.TODO(), *received.ID)
+	test.Require().NoError(err)
+	test.Equal(MessageStatusReceived, stored.StatusHistory[0].Status)
+}
+
+func (test *RepositoryTest) TestRelayCreated() {
+	msg := message{Module: "test_module", Component: "test_component", Method: "test_method"}
+	test.Require().NoError(test.repository.CreateMessage(context.TODO(), &msg))
+
+	orchestration := NewMessageOrchestration("relay")
+	step, err := NewMessage("test_module", "test_component", "test_method", nil)
+	test.Require().NoError(err)
+	orchestration.AddStep("step", []Message{step})
+	test.Require().NoError(test.repository.CreateMessage(context.TODO(), step.(*message)))
+
+	test.Run("publish failed", func() {
+		_, err := test.repository.RelayCreated(context.TODO(), time.Now(), 10, func(m *message) error {
+			return errors.New("nats down")
+		})
+		test.Require().Error(err)
+
+		stored, err := test.repository.GetMessage(context.TODO(), *msg.ID)
+		test.Require().NoError(err)
+		test.Equal(MessageStatusCreated, stored.StatusHistory[0].Status)
+	})
+	test.Run("too new", func() {
+		relayed, err := test.repository.RelayCreated(context.TODO(), time.Now().Add(-time.Hour), 10, func(m *message) error {
+			return nil
+		})
+		test.Require().NoError(err)
+		test.Equal(0, relayed)
+	})
+	test.Run("relayed", func() {
+		published := []string{}
+		relayed, err := test.repository.RelayCreated(context.TODO(), time.Now(), 10, func(m *message) error {
+			published = append(published, *m.ID)
+			return nil
+		})
+		test.Require().NoError(err)
+		test.Equal(1, relayed)
+		test.Equal([]string{*msg.ID}, published)
+
+		stored, err := test.repository.GetMessage(context.TODO(), *msg.ID)
+		test.Require().NoError(err)
+		test.Equal(MessageStatusPublished, stored.StatusHistory[0].Status)
+
+		relayed, err = test.repository.RelayCreated(context.TODO(), time.Now(), 10, func(m *message) error {
+			return nil
+		})
+		test.Require().NoError(err)
+		test.Equal(0, relayed)
+	})
+}
+
 func (test *RepositoryTest) TestOrchestrationIsComplete() {
 
 	createBaseOrchestration := func(_ *testing.T) *MessageOrchestration {
@@ -354,3 +733,138 @@ UPDATE message set status='ERROR' WHERE orchestration_step_number=2;
 		})
 	}
 }
+
+func (test *RepositoryTest) TestDeadLetter() {
+	msg := message{
+		Module:    "test_module",
+		Component: "test_component",
+		Method:    "test_method",
+	}
+	test.Require().NoError(test.repository.CreateMessage(context.TODO(), &msg))
+	test.Require().NoError(test.repository.UpdateMessageStatus(context.TODO(), *msg.ID, MessageStatusPublished, nil))
+
+	_, err := test.repository.UpdatePublishedAndGetMessage(context.TODO(), *msg.ID)
+	test.Require().NoError(err)
+	test.Require().NoError(test.repository.UpdateMessageStatus(context.TODO(), *msg.ID, MessageStatusError, errors.New("failed")))
+	test.Require().NoError(test.repository.CreateDeadLetter(context.TODO(), *msg.ID, 1, errors.New("failed")))
+
+	deadLetters, err := test.repository.ListDeadLetters(context.TODO())
+	test.Require().NoError(err)
+	test.Require().Len(*deadLetters, 1)
+	test.Equal(*msg.ID, *(*deadLetters)[0].ID)
+	test.Equal(1, (*deadLetters)[0].Attempts)
+
+	redriven, err := test.repository.RedriveDeadLetter(context.TODO(), *msg.ID)
+	test.Require().NoError(err)
+	test.Equal(0, redriven.Attempts)
+	test.Equal(MessageStatusCreated, redriven.StatusHistory[0].Status)
+
+	deadLetters, err = test.repository.ListDeadLetters(context.TODO())
+	test.Require().NoError(err)
+	test.Empty(*deadLetters)
+
+	_, err = test.repository.RedriveDeadLetter(context.TODO(), *msg.ID)
+	test.Require().ErrorIs(err, pgx.ErrNoRows)
+}
+
+func (test *RepositoryTest) TestScheduledMessages() {
+	test.Run("due messages", func() {
+		now := time.Now()
+
+		due := message{Module: "test_module", Component: "test_component", Method: "due"}
+		test.Require().NoError(test.repository.CreateScheduledMessage(context.TODO(), &due, now.Add(-time.Minute)))
+		later := message{Module: "test_module", Component: "test_component", Method: "later"}
+		test.Require().NoError(test.repository.CreateScheduledMessage(context.TODO(), &later, now.Add(time.Hour)))
+
+		msgs, err := test.repository.ListDueMessages(context.TODO(), MessageStatusScheduled, now)
+		test.Require().NoError(err)
+		test.Require().Len(*msgs, 1)
+		test.Equal(*due.ID, *(*msgs)[0].ID)
+		test.Equal("due", (*msgs)[0].Method)
+
+		stored, err := test.repository.GetMessage(context.TODO(), *later.ID)
+		test.Require().NoError(err)
+		test.Equal(MessageStatusScheduled, stored.StatusHistory[0].Status)
+
+		claimed, err := test.repository.ClaimAndPublish(context.TODO(), *due.ID, MessageStatusScheduled, func() error {
+			return nil
+		})
+		test.Require().NoError(err)
+		test.True(claimed)
+
+		msgs, err = test.repository.ListDueMessages(context.TODO(), MessageStatusScheduled, now)
+		test.Require().NoError(err)
+		test.Empty(*msgs)
+	})
+	test.Run("due retries", func() {
+		now := time.Now()
+
+		due := message{Module: "test_module", Component: "test_component", Method: "due"}
+		test.Require().NoError(test.repository.CreateMessage(context.TODO(), &due))
+		test.Require().NoError(test.repository.UpdateMessageRetry(context.TODO(), *due.ID, errors.New("failed"), now.Add(-time.Second)))
+		later := message{Module: "test_module", Component: "test_component", Method: "later"}
+		test.Require().NoError(test.repository.CreateMessage(context.TODO(), &later))
+		test.Require().NoError(test.repository.UpdateMessageRetry(context.TODO(), *later.ID, errors.New("failed"), now.Add(time.Hour)))
+
+		msgs, err := test.repository.ListDueMessages(context.TODO(), MessageStatusRetry, now)
+		test.Require().NoError(err)
+		test.Require().Len(*msgs, 1)
+		test.Equal(*due.ID, *(*msgs)[0].ID)
+
+		msgs, err = test.repository.ListDueMessages(context.TODO(), MessageStatusScheduled, now)
+		test.Require().NoError(err)
+		test.Empty(*msgs)
+	})
+}
+
+func (test *RepositoryTest) TestSchedules() {
+	test.Run("upsert, list and delete", func() {
+		s := schedule{Name: "nightly", Cron: "0 0 * * *", Module: "finance", Component: "marketdata", Method: "ingest",
+			Payload: []byte(`{"symbols": ["AAPL"]}`), NextRunAt: time.Now().Add(time.Hour)}
+		test.Require().NoError(test.repository.UpsertSchedule(context.TODO(), &s))
+		test.False(s.CreatedAt.IsZero())
+
+		s.Cron = "0 1 * * *"
+		test.Require().NoError(test.repository.UpsertSchedule(context.TODO(), &s))
+
+		schedules, err := test.repository.ListSchedules(context.TODO())
+		test.Require().NoError(err)
+		test.Require().Len(*schedules, 1)
+		test.Equal("nightly", (*schedules)[0].Name)
+		test.Equal("0 1 * * *", (*schedules)[0].Cron)
+		test.Nil((*schedules)[0].LastRunAt)
+
+		test.Require().NoError(test.repository.DeleteSchedule(context.TODO(), "nightly"))
+		test.ErrorIs(test.repository.DeleteSchedule(context.TODO(), "nightly"), pgx.ErrNoRows)
+	})
+	test.Run("fire due schedules", func() {
+		now := time.Now()
+
+		due := schedule{Name: "due", Cron: "* * * * *", Module: "finance", Component: "marketdata", Method: "ingest",
+			NextRunAt: now.Add(-time.Minute)}
+		test.Require().NoError(test.repository.UpsertSchedule(context.TODO(), &due))
+		later := schedule{Name: "later", Cron: "* * * * *", Module: "finance", Component: "marketdata", Method: "ingest",
+			NextRunAt: now.Add(time.Hour)}
+		test.Require().NoError(test.repository.UpsertSchedule(context.TODO(), &later))
+
+		fired, err := test.repository.FireDueSchedules(context.TODO(), now)
+		test.Require().NoError(err)
+		test.Equal(1, fired)
+
+		msgs, err := test.repository.ListDueMessages(context.TODO(), MessageStatusScheduled, now)
+		test.Require().NoError(err)
+		test.Require().Len(*msgs, 1)
+		test.Equal("ingest", (*msgs)[0].Method)
+
+		schedules, err := test.repository.ListSchedules(context.TODO())
+		test.Require().NoError(err)
+		test.Require().Len(*schedules, 2)
+		test.Require().NotNil((*schedules)[0].LastRunAt)
+		test.True((*schedules)[0].NextRunAt.After(now))
+		test.Nil((*schedules)[1].LastRunAt)
+
+		fired, err = test.repository.FireDueSchedules(context.TODO(), now)
+		test.Require().NoError(err)
+		test.Equal(0, fired)
+	})
+}
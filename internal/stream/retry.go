@@ -0,0 +1,161 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+var ErrNonRetryable = errors.New("non retryable error")
+
+// NonRetryable marks an error returned from a command handler as permanent, the
+// message will not be attempted again regardless of the retry policy.
+func NonRetryable(err error) error {
+	return fmt.Errorf("%w: %w", ErrNonRetryable, err)
+}
+
+// RetryPolicy describes how many times a command is attempted, how long to wait
+// between the attempts and which errors are worth retrying.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Retryable      func(err error) bool
+}
+
+// DefaultRetryPolicy attempts a command once, failing it on the first error.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 1,
+	}
+}
+
+// ExponentialRetryPolicy attempts a command up to maxAttempts times, doubling the
+// wait between each attempt until maxBackoff is reached.
+func ExponentialRetryPolicy(maxAttempts int, initialBackoff, maxBackoff time.Duration) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: initialBackoff,
+		MaxBackoff:     maxBackoff,
+		Multiplier:     2,
+	}
+}
+
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return !errors.Is(err, ErrNonRetryable) && !errors.Is(err, context.Canceled)
+}
+
+// ShouldRetry reports if a message that failed on the given attempt, counted
+// from one, should be attempted again.
+func (rp RetryPolicy) ShouldRetry(attempt int, err error) bool {
+	if attempt >= rp.MaxAttempts {
+		return false
+	}
+
+	if !IsRetryable(err) {
+		return false
+	}
+
+	if rp.Retryable != nil {
+		return rp.Retryable(err)
+	}
+
+	return true
+}
+
+// Backoff returns how long to wait before the attempt following the given one.
+func (rp RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 || rp.InitialBackoff <= 0 {
+		return rp.InitialBackoff
+	}
+
+	multiplier := rp.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	backoff := float64(rp.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if rp.MaxBackoff > 0 && backoff > float64(rp.MaxBackoff) {
+		return rp.MaxBackoff
+	}
+
+	return time.Duration(backoff)
+}
+
+type subscriberOptions struct {
+	retryPolicy RetryPolicy
+	concurrency int
+}
+
+type SubscriberOption func(*subscriberOptions)
+
+func WithRetryPolicy(policy RetryPolicy) SubscriberOption {
+	return func(o *subscriberOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithConcurrency limits how many of the commands are handled at once, on top of the
+// limit of the module. Without it only the module limit applies.
+func WithConcurrency(concurrency int) SubscriberOption {
+	return func(o *subscriberOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+func newSubscriberOptions(opts ...SubscriberOption) *subscriberOptions {
+	options := &subscriberOptions{
+		retryPolicy: DefaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options
+}
+
+type retryTimers struct {
+	lock   sync.Mutex
+	timers map[string]*time.Timer
+}
+
+func newRetryTimers() *retryTimers {
+	return &retryTimers{
+		timers: make(map[string]*time.Timer),
+	}
+}
+
+func (rt *retryTimers) schedule(id string, backoff time.Duration, retry func()) {
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+
+	if timer, exists := rt.timers[id]; exists {
+		timer.Stop()
+	}
+
+	rt.timers[id] = time.AfterFunc(backoff, func() {
+		rt.lock.Lock()
+		delete(rt.timers, id)
+		rt.lock.Unlock()
+
+		retry()
+	})
+}
+
+func (rt *retryTimers) stop() {
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+
+	for id, timer := range rt.timers {
+		timer.Stop()
+		delete(rt.timers, id)
+	}
+}
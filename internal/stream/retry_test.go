@@ -0,0 +1,59 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RetryPolicyTest struct {
+	suite.Suite
+}
+
+func TestRetryPolicy(t *testing.T) {
+	suite.Run(t, new(RetryPolicyTest))
+}
+
+func (test *RetryPolicyTest) TestShouldRetry() {
+	policy := ExponentialRetryPolicy(3, time.Second, time.Minute)
+
+	test.True(policy.ShouldRetry(1, errors.New("failed")))
+	test.True(policy.ShouldRetry(2, errors.New("failed")))
+	test.False(policy.ShouldRetry(3, errors.New("failed")))
+	test.False(policy.ShouldRetry(1, nil))
+	test.False(policy.ShouldRetry(1, NonRetryable(errors.New("failed"))))
+	test.False(policy.ShouldRetry(1, context.Canceled))
+
+	policy.Retryable = func(err error) bool { return false }
+	test.False(policy.ShouldRetry(1, errors.New("failed")))
+
+	test.False(DefaultRetryPolicy().ShouldRetry(1, errors.New("failed")))
+}
+
+func (test *RetryPolicyTest) TestBackoff() {
+	policy := ExponentialRetryPolicy(10, time.Second, 5*time.Second)
+
+	test.Equal(time.Second, policy.Backoff(1))
+	test.Equal(2*time.Second, policy.Backoff(2))
+	test.Equal(4*time.Second, policy.Backoff(3))
+	test.Equal(5*time.Second, policy.Backoff(4))
+}
+
+func (test *RetryPolicyTest) TestRetryTimers() {
+	timers := newRetryTimers()
+	retried := make(chan struct{})
+
+	timers.schedule("retry", time.Millisecond, func() { close(retried) })
+	select {
+	case <-retried:
+	case <-time.After(time.Second):
+		test.Fail("retry was not executed")
+	}
+
+	timers.schedule("stopped", time.Hour, func() { test.Fail("retry should have been stopped") })
+	timers.stop()
+	test.Empty(timers.timers)
+}
@@ -0,0 +1,114 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type schedule struct {
+	Name          string
+	Cron          string
+	Module        string
+	Component     string
+	Method        string
+	Payload       []byte
+	SchemaVersion int
+	NextRunAt     time.Time
+	LastRunAt     *time.Time
+	CreatedAt     time.Time
+}
+
+func newSchedule(name, spec string, msg Message, now time.Time) (*schedule, error) {
+	m, isMsg := msg.(*message)
+	if !isMsg {
+		return nil, errors.New("invalid message")
+	}
+
+	if name == "" {
+		return nil, errors.New("schedule name is required")
+	}
+
+	cron, err := parseCron(spec)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing cron expression: %w", err)
+	}
+
+	return &schedule{
+		Name:          name,
+		Cron:          spec,
+		Module:        m.Module,
+		Component:     m.Component,
+		Method:        m.Method,
+		Payload:       m.Payload,
+		SchemaVersion: m.GetSchemaVersion(),
+		NextRunAt:     cron.Next(now),
+	}, nil
+}
+
+// PublishAt stores the message and publishes it once at has passed, the message is
+// published right away if at is not in the future.
+func (ns *NATSStream) PublishAt(ctx context.Context, msg Message, at time.Time) error {
+	if !at.After(time.Now()) {
+		return ns.Publish(ctx, msg)
+	}
+
+	m, isMsg := msg.(*message)
+	if !isMsg {
+		return errors.New("invalid message")
+	}
+
+	if m.ID != nil {
+		return errors.New("message is already stored")
+	}
+
+	if err := ns.repository.CreateScheduledMessage(ctx, m, at); err != nil {
+		return fmt.Errorf("error creating scheduled message: %w", err)
+	}
+
+	return nil
+}
+
+// Schedule creates or replaces the named schedule, a copy of the message is published
+// every time the cron expression matches.
+func (ns *NATSStream) Schedule(ctx context.Context, name, spec string, msg Message) error {
+	s, err := newSchedule(name, spec, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if err = ns.repository.UpsertSchedule(ctx, s); err != nil {
+		return fmt.Errorf("error storing schedule: %w", err)
+	}
+
+	return nil
+}
+
+// publishDue fires due schedules and publishes every scheduled message and retry that is
+// due. Retries are normally published by the subscriber that failed them, this picks up
+// the ones left behind by a replica that stopped.
+func (ns *NATSStream) publishDue(ctx context.Context, now time.Time) error {
+	_, err := ns.repository.FireDueSchedules(ctx, now)
+	if err != nil {
+		return fmt.Errorf("error firing schedules: %w", err)
+	}
+
+	for _, status := range []MessageStatus{MessageStatusScheduled, MessageStatusRetry} {
+		msgs, err := ns.repository.ListDueMessages(ctx, status, now)
+		if err != nil {
+			return fmt.Errorf("error listing due messages: %w", err)
+		}
+
+		for _, msg := range *msgs {
+			_, err := ns.repository.ClaimAndPublish(ctx, *msg.ID, status, func() error {
+				return ns.publish(&msg)
+			})
+			if err != nil {
+				return fmt.Errorf("error publishing due message: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
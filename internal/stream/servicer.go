@@ -0,0 +1,489 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/environment"
+	internal_pb "github.com/lhjnilsson/foreverbull/pkg/pb"
+	pb "github.com/lhjnilsson/foreverbull/pkg/pb/stream"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OrchestrationServer struct {
+	pb.UnimplementedOrchestrationServicerServer
+
+	pgx        *pgxpool.Pool
+	stream     *NATSStream
+	repository repository
+}
+
+func NewOrchestrationServer(jt nats.JetStreamContext, pgx *pgxpool.Pool) *OrchestrationServer {
+	return &OrchestrationServer{
+		pgx:        pgx,
+		stream:     newOrchestrationStream(jt, pgx),
+		repository: NewRepository(pgx),
+	}
+}
+
+func orchestrationStatusToPb(orchestrationStatus OrchestrationStatus) pb.Orchestration_Status {
+	switch orchestrationStatus {
+	case OrchestrationStatusCreated:
+		return pb.Orchestration_CREATED
+	case OrchestrationStatusRunning:
+		return pb.Orchestration_RUNNING
+	case OrchestrationStatusCompleted:
+		return pb.Orchestration_COMPLETED
+	case OrchestrationStatusFailed:
+		return pb.Orchestration_FAILED
+	case OrchestrationStatusCanceled:
+		return pb.Orchestration_CANCELED
+	}
+
+	return pb.Orchestration_CREATED
+}
+
+func messageToPb(msg *message) *pb.Command {
+	command := &pb.Command{
+		Id:                msg.GetID(),
+		Module:            msg.Module,
+		Component:         msg.Component,
+		Method:            msg.Method,
+		Payload:           msg.Payload,
+		Attempts:          int32(msg.Attempts),
+		SchemaVersion:     int32(msg.GetSchemaVersion()),
+		OrchestrationId:   msg.OrchestrationID,
+		OrchestrationStep: msg.OrchestrationStep,
+	}
+	for _, s := range msg.StatusHistory {
+		command.Statuses = append(command.Statuses, &pb.Command_Status{
+			Status:     string(s.Status),
+			Error:      s.Error,
+			Attempt:    int32(s.Attempt),
+			OccurredAt: internal_pb.TimeToProtoTimestamp(s.OccurredAt),
+		})
+	}
+
+	return command
+}
+
+func orchestrationToPb(o *orchestration) *pb.Orchestration {
+	orchestration := &pb.Orchestration{
+		Id:        o.ID,
+		Name:      o.Name,
+		Status:    orchestrationStatusToPb(o.Status),
+		CreatedAt: internal_pb.TimeToProtoTimestamp(o.CreatedAt),
+	}
+
+	steps := make(map[string]*pb.OrchestrationStep)
+
+	for i := range o.Messages {
+		msg := &o.Messages[i]
+
+		key := msg.GetOrchestrationStep()
+		if isCompensation(msg) {
+			key = "compensation " + key
+		}
+
+		step, exists := steps[key]
+		if !exists {
+			step = &pb.OrchestrationStep{
+				Name:         msg.GetOrchestrationStep(),
+				DependsOn:    msg.OrchestrationStepDependsOn,
+				Fallback:     isFallback(msg) && !isCompensation(msg),
+				Compensation: isCompensation(msg),
+			}
+			if msg.OrchestrationStepNumber != nil {
+				step.StepNumber = int32(*msg.OrchestrationStepNumber)
+			}
+
+			steps[key] = step
+			orchestration.Steps = append(orchestration.Steps, step)
+		}
+
+		step.Commands = append(step.Commands, messageToPb(msg))
+	}
+
+	return orchestration
+}
+
+func (os *OrchestrationServer) ListOrchestrations(ctx context.Context, req *pb.ListOrchestrationsRequest) (*pb.ListOrchestrationsResponse, error) {
+	orchestrations, err := os.repository.ListOrchestrations(ctx, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error listing orchestrations: %w", err)
+	}
+
+	rsp := pb.ListOrchestrationsResponse{}
+	for i := range *orchestrations {
+		rsp.Orchestrations = append(rsp.Orchestrations, orchestrationToPb(&(*orchestrations)[i]))
+	}
+
+	return &rsp, nil
+}
+
+func (os *OrchestrationServer) GetOrchestration(ctx context.Context, req *pb.GetOrchestrationRequest) (*pb.GetOrchestrationResponse, error) {
+	orchestration, err := os.repository.GetOrchestration(ctx, req.GetOrchestrationId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "orchestration not found")
+		}
+
+		return nil, fmt.Errorf("error getting orchestration: %w", err)
+	}
+
+	return &pb.GetOrchestrationResponse{
+		Orchestration: orchestrationToPb(orchestration),
+	}, nil
+}
+
+// WatchOrchestration sends the current state of the orchestration followed by every
+// command status change, it returns once no command in the orchestration is active.
+func (os *OrchestrationServer) WatchOrchestration(req *pb.WatchOrchestrationRequest, stream pb.OrchestrationServicer_WatchOrchestrationServer) error {
+	conn, err := os.pgx.Acquire(stream.Context())
+	if err != nil {
+		return fmt.Errorf("error acquiring connection: %w", err)
+	}
+	defer conn.Release()
+
+	_, err = conn.Exec(stream.Context(), "LISTEN message_status")
+	if err != nil {
+		return fmt.Errorf("error listening to message status: %w", err)
+	}
+
+	defer conn.Exec(context.Background(), "UNLISTEN message_status") //nolint: errcheck
+
+	orchestration, err := os.repository.GetOrchestration(stream.Context(), req.GetOrchestrationId())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "orchestration not found")
+		}
+
+		return fmt.Errorf("error getting orchestration: %w", err)
+	}
+
+	err = stream.Send(&pb.WatchOrchestrationResponse{Orchestration: orchestrationToPb(orchestration)})
+	if err != nil {
+		return fmt.Errorf("error sending orchestration: %w", err)
+	}
+
+	for orchestration.Active {
+		notification, err := conn.Conn().WaitForNotification(stream.Context())
+		if err != nil {
+			return fmt.Errorf("error waiting for notification: %w", err)
+		}
+
+		msg, err := os.repository.GetMessage(stream.Context(), notification.Payload)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Removed by the janitor since the notification was sent
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting message: %w", err)
+		}
+
+		if msg.GetOrchestrationID() != req.GetOrchestrationId() {
+			continue
+		}
+
+		orchestration, err = os.repository.GetOrchestrationSummary(stream.Context(), req.GetOrchestrationId())
+		if err != nil {
+			return fmt.Errorf("error getting orchestration: %w", err)
+		}
+
+		err = stream.Send(&pb.WatchOrchestrationResponse{
+			Orchestration: orchestrationToPb(orchestration),
+			Command:       messageToPb(msg),
+		})
+		if err != nil {
+			return fmt.Errorf("error sending orchestration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func orchestrationErrorToStatus(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return status.Error(codes.NotFound, "orchestration not found")
+	case errors.Is(err, ErrOrchestrationActive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+func (os *OrchestrationServer) ResumeOrchestration(ctx context.Context, req *pb.ResumeOrchestrationRequest) (*pb.ResumeOrchestrationResponse, error) {
+	err := os.stream.ResumeOrchestration(ctx, req.GetOrchestrationId())
+	if err != nil {
+		return nil, orchestrationErrorToStatus(fmt.Errorf("error resuming orchestration: %w", err))
+	}
+
+	orchestration, err := os.repository.GetOrchestration(ctx, req.GetOrchestrationId())
+	if err != nil {
+		return nil, fmt.Errorf("error getting orchestration: %w", err)
+	}
+
+	return &pb.ResumeOrchestrationResponse{
+		Orchestration: orchestrationToPb(orchestration),
+	}, nil
+}
+
+func (os *OrchestrationServer) RetryOrchestrationStep(ctx context.Context, req *pb.RetryOrchestrationStepRequest) (*pb.RetryOrchestrationStepResponse, error) {
+	err := os.stream.RetryOrchestrationStep(ctx, req.GetOrchestrationId(), req.GetStep())
+	if err != nil {
+		return nil, orchestrationErrorToStatus(fmt.Errorf("error retrying orchestration step: %w", err))
+	}
+
+	orchestration, err := os.repository.GetOrchestration(ctx, req.GetOrchestrationId())
+	if err != nil {
+		return nil, fmt.Errorf("error getting orchestration: %w", err)
+	}
+
+	return &pb.RetryOrchestrationStepResponse{
+		Orchestration: orchestrationToPb(orchestration),
+	}, nil
+}
+
+func (os *OrchestrationServer) CancelOrchestration(ctx context.Context, req *pb.CancelOrchestrationRequest) (*pb.CancelOrchestrationResponse, error) {
+	err := os.stream.CancelOrchestration(ctx, req.GetOrchestrationId())
+	if err != nil {
+		return nil, orchestrationErrorToStatus(fmt.Errorf("error canceling orchestration: %w", err))
+	}
+
+	orchestration, err := os.repository.GetOrchestration(ctx, req.GetOrchestrationId())
+	if err != nil {
+		return nil, orchestrationErrorToStatus(fmt.Errorf("error getting orchestration: %w", err))
+	}
+
+	return &pb.CancelOrchestrationResponse{
+		Orchestration: orchestrationToPb(orchestration),
+	}, nil
+}
+
+// messageListLimit is the number of messages listed when the request has no limit.
+const messageListLimit = 100
+
+type MessageServer struct {
+	pb.UnimplementedMessageServicerServer
+
+	stream     *NATSStream
+	repository repository
+}
+
+func NewMessageServer(jt nats.JetStreamContext, pgx *pgxpool.Pool) *MessageServer {
+	return &MessageServer{
+		stream:     newOrchestrationStream(jt, pgx),
+		repository: NewRepository(pgx),
+	}
+}
+
+func messageErrorToStatus(err error) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return status.Error(codes.NotFound, "message not found")
+	case errors.Is(err, ErrMessageActive), errors.Is(err, ErrOrchestrationMessage):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+func (ms *MessageServer) ListMessages(ctx context.Context, req *pb.ListMessagesRequest) (*pb.ListMessagesResponse, error) {
+	filter := messageFilter{
+		Module:    req.Module,
+		Component: req.Component,
+		Method:    req.Method,
+	}
+	if req.Status != nil {
+		messageStatus := MessageStatus(req.GetStatus())
+		filter.Status = &messageStatus
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = messageListLimit
+	}
+
+	msgs, err := ms.repository.ListMessages(ctx, filter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error listing messages: %w", err)
+	}
+
+	rsp := pb.ListMessagesResponse{}
+	for i := range *msgs {
+		rsp.Commands = append(rsp.Commands, messageToPb(&(*msgs)[i]))
+	}
+
+	return &rsp, nil
+}
+
+func (ms *MessageServer) GetMessage(ctx context.Context, req *pb.GetMessageRequest) (*pb.GetMessageResponse, error) {
+	msg, err := ms.repository.GetMessage(ctx, req.GetMessageId())
+	if err != nil {
+		return nil, messageErrorToStatus(fmt.Errorf("error getting message: %w", err))
+	}
+
+	return &pb.GetMessageResponse{
+		Command: messageToPb(msg),
+	}, nil
+}
+
+func (ms *MessageServer) ReplayMessage(ctx context.Context, req *pb.ReplayMessageRequest) (*pb.ReplayMessageResponse, error) {
+	msg, err := ms.stream.ReplayMessage(ctx, req.GetMessageId(), req.GetNewId())
+	if err != nil {
+		return nil, messageErrorToStatus(fmt.Errorf("error replaying message: %w", err))
+	}
+
+	return &pb.ReplayMessageResponse{
+		Command: messageToPb(msg),
+	}, nil
+}
+
+func (ms *MessageServer) ListDeadLetters(ctx context.Context, _ *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	msgs, err := ms.repository.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing dead letters: %w", err)
+	}
+
+	rsp := pb.ListDeadLettersResponse{}
+	for i := range *msgs {
+		msg := &(*msgs)[i]
+
+		deadLetter := &pb.ListDeadLettersResponse_DeadLetter{
+			Command: messageToPb(msg),
+		}
+		if msg.Error != nil {
+			deadLetter.Error = *msg.Error
+		}
+
+		rsp.DeadLetters = append(rsp.DeadLetters, deadLetter)
+	}
+
+	return &rsp, nil
+}
+
+func (ms *MessageServer) RedriveDeadLetter(ctx context.Context, req *pb.RedriveDeadLetterRequest) (*pb.RedriveDeadLetterResponse, error) {
+	msg, err := ms.stream.RedriveDeadLetter(ctx, req.GetMessageId())
+	if err != nil {
+		return nil, messageErrorToStatus(fmt.Errorf("error redriving dead letter: %w", err))
+	}
+
+	return &pb.RedriveDeadLetterResponse{
+		Command: messageToPb(msg),
+	}, nil
+}
+
+type ScheduleServer struct {
+	pb.UnimplementedScheduleServicerServer
+
+	repository repository
+}
+
+func NewScheduleServer(pgx *pgxpool.Pool) *ScheduleServer {
+	return &ScheduleServer{
+		repository: NewRepository(pgx),
+	}
+}
+
+func scheduleToPb(s *schedule) *pb.Schedule {
+	rsp := &pb.Schedule{
+		Name:      s.Name,
+		Cron:      s.Cron,
+		Module:    s.Module,
+		Component: s.Component,
+		Method:    s.Method,
+		Payload:   s.Payload,
+		NextRunAt: internal_pb.TimeToProtoTimestamp(s.NextRunAt),
+		CreatedAt: internal_pb.TimeToProtoTimestamp(s.CreatedAt),
+	}
+	if s.LastRunAt != nil {
+		rsp.LastRunAt = internal_pb.TimeToProtoTimestamp(*s.LastRunAt)
+	}
+
+	return rsp
+}
+
+func (ss *ScheduleServer) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error) {
+	schedules, err := ss.repository.ListSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing schedules: %w", err)
+	}
+
+	rsp := pb.ListSchedulesResponse{}
+	for i := range *schedules {
+		rsp.Schedules = append(rsp.Schedules, scheduleToPb(&(*schedules)[i]))
+	}
+
+	return &rsp, nil
+}
+
+func (ss *ScheduleServer) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.CreateScheduleResponse, error) {
+	var payload json.RawMessage
+	if len(req.GetPayload()) > 0 {
+		if !json.Valid(req.GetPayload()) {
+			return nil, status.Error(codes.InvalidArgument, "payload must be valid json")
+		}
+
+		payload = req.GetPayload()
+	}
+
+	msg, err := NewMessage(req.GetModule(), req.GetComponent(), req.GetMethod(), payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating message: %w", err)
+	}
+
+	s, err := newSchedule(req.GetName(), req.GetCron(), msg, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = ss.repository.UpsertSchedule(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("error storing schedule: %w", err)
+	}
+
+	return &pb.CreateScheduleResponse{
+		Schedule: scheduleToPb(s),
+	}, nil
+}
+
+func (ss *ScheduleServer) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*pb.DeleteScheduleResponse, error) {
+	err := ss.repository.DeleteSchedule(ctx, req.GetName())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "schedule not found")
+		}
+
+		return nil, fmt.Errorf("error deleting schedule: %w", err)
+	}
+
+	return &pb.DeleteScheduleResponse{}, nil
+}
+
+var OrchestrationServicerModule = fx.Options( //nolint: gochecknoglobals
+	fx.Invoke(
+		func(s *grpc.Server, jt nats.JetStreamContext, pgx *pgxpool.Pool) {
+			if environment.GetStreamBackend() == MemoryBackend {
+				// The memory backend has no message tables to list, cancel or redrive from
+				pb.RegisterOrchestrationServicerServer(s, pb.UnimplementedOrchestrationServicerServer{})
+				pb.RegisterScheduleServicerServer(s, pb.UnimplementedScheduleServicerServer{})
+				pb.RegisterMessageServicerServer(s, pb.UnimplementedMessageServicerServer{})
+				return
+			}
+			pb.RegisterOrchestrationServicerServer(s, NewOrchestrationServer(jt, pgx))
+			pb.RegisterScheduleServicerServer(s, NewScheduleServer(pgx))
+			pb.RegisterMessageServicerServer(s, NewMessageServer(jt, pgx))
+		},
+	),
+)
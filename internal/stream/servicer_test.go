@@ -0,0 +1,375 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/environment"
+	internalGrpc "github.com/lhjnilsson/foreverbull/internal/grpc"
+	"github.com/lhjnilsson/foreverbull/internal/test_helper"
+	pb "github.com/lhjnilsson/foreverbull/pkg/pb/stream"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type OrchestrationServerTest struct {
+	suite.Suite
+
+	pgx        *pgxpool.Pool
+	nc         *nats.Conn
+	jt         nats.JetStreamContext
+	repository repository
+
+	listener       *bufconn.Listener
+	server         *grpc.Server
+	client         pb.OrchestrationServicerClient
+	scheduleClient pb.ScheduleServicerClient
+	messageClient  pb.MessageServicerClient
+}
+
+func TestOrchestrationServerTest(t *testing.T) {
+	suite.Run(t, new(OrchestrationServerTest))
+}
+
+func (test *OrchestrationServerTest) SetupSuite() {
+	test_helper.SetupEnvironment(test.T(), &test_helper.Containers{
+		Postgres: true,
+		NATS:     true,
+	})
+}
+
+func (test *OrchestrationServerTest) SetupTest() {
+	var err error
+	test.pgx, err = pgxpool.New(context.Background(), environment.GetPostgresURL())
+	test.Require().NoError(err)
+	test.Require().NoError(RecreateTables(context.TODO(), test.pgx))
+	test.repository = NewRepository(test.pgx)
+
+	test.nc, test.jt, err = New()
+	test.Require().NoError(err)
+
+	test.listener = bufconn.Listen(1024 * 1024)
+	test.server, err = internalGrpc.NewServer()
+	test.Require().NoError(err)
+	pb.RegisterOrchestrationServicerServer(test.server, NewOrchestrationServer(test.jt, test.pgx))
+	pb.RegisterScheduleServicerServer(test.server, NewScheduleServer(test.pgx))
+	pb.RegisterMessageServicerServer(test.server, NewMessageServer(test.jt, test.pgx))
+
+	go func() {
+		test.NoError(test.server.Serve(test.listener))
+	}()
+
+	resolver.SetDefaultScheme("passthrough")
+
+	conn, err := grpc.NewClient(test.listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(_ context.Context, _ string) (net.Conn, error) {
+			return test.listener.Dial()
+		}),
+	)
+	if err != nil {
+		log.Printf("error connecting to server: %v", err)
+	}
+
+	test.client = pb.NewOrchestrationServicerClient(conn)
+	test.scheduleClient = pb.NewScheduleServicerClient(conn)
+	test.messageClient = pb.NewMessageServicerClient(conn)
+}
+
+func (test *OrchestrationServerTest) TearDownTest() {
+	test.server.Stop()
+	test.nc.Close()
+}
+
+func (test *OrchestrationServerTest) createOrchestration() *MessageOrchestration {
+	orchestration := NewMessageOrchestration("servicer_test")
+
+	msg, err := NewMessage("service", "service", "start", nil)
+	test.Require().NoError(err)
+	orchestration.AddStep("start", []Message{msg})
+
+	msg, err = NewMessage("service", "instance", "stop", nil)
+	test.Require().NoError(err)
+	orchestration.AddStep("stop", []Message{msg})
+
+	msg, err = NewMessage("service", "instance", "stop", nil)
+	test.Require().NoError(err)
+	orchestration.SettFallback([]Message{msg})
+
+	for _, step := range orchestration.Steps {
+		for _, cmd := range step.Commands {
+			test.Require().NoError(test.repository.CreateMessage(context.TODO(), cmd.(*message)))
+		}
+	}
+
+	for _, cmd := range orchestration.FallbackStep.Commands {
+		test.Require().NoError(test.repository.CreateMessage(context.TODO(), cmd.(*message)))
+	}
+
+	return orchestration
+}
+
+func (test *OrchestrationServerTest) TestListOrchestrations() {
+	rsp, err := test.client.ListOrchestrations(context.TODO(), &pb.ListOrchestrationsRequest{})
+	test.Require().NoError(err)
+	test.Empty(rsp.Orchestrations)
+
+	orchestration := test.createOrchestration()
+
+	rsp, err = test.client.ListOrchestrations(context.TODO(), &pb.ListOrchestrationsRequest{})
+	test.Require().NoError(err)
+	test.Require().Len(rsp.Orchestrations, 1)
+	test.Equal(orchestration.OrchestrationID, rsp.Orchestrations[0].Id)
+	test.Equal(pb.Orchestration_CREATED, rsp.Orchestrations[0].Status)
+
+	name := "other"
+	rsp, err = test.client.ListOrchestrations(context.TODO(), &pb.ListOrchestrationsRequest{Name: &name})
+	test.Require().NoError(err)
+	test.Empty(rsp.Orchestrations)
+}
+
+func (test *OrchestrationServerTest) TestGetOrchestration() {
+	_, err := test.client.GetOrchestration(context.TODO(), &pb.GetOrchestrationRequest{OrchestrationId: "unknown"})
+	test.Require().Error(err)
+	test.Equal(codes.NotFound, status.Code(err))
+
+	orchestration := test.createOrchestration()
+
+	_, err = test.pgx.Exec(context.TODO(), `UPDATE message SET status='COMPLETE' WHERE orchestration_step='start'`)
+	test.Require().NoError(err)
+
+	rsp, err := test.client.GetOrchestration(context.TODO(), &pb.GetOrchestrationRequest{OrchestrationId: orchestration.OrchestrationID})
+	test.Require().NoError(err)
+	test.Equal(pb.Orchestration_RUNNING, rsp.Orchestration.Status)
+	test.Require().Len(rsp.Orchestration.Steps, 3)
+	test.Equal("start", rsp.Orchestration.Steps[0].Name)
+	test.Equal("stop", rsp.Orchestration.Steps[1].Name)
+	test.Equal([]string{"start"}, rsp.Orchestration.Steps[1].DependsOn)
+	test.True(rsp.Orchestration.Steps[2].Fallback)
+	test.Len(rsp.Orchestration.Steps[0].Commands[0].Statuses, 2)
+}
+
+func (test *OrchestrationServerTest) TestWatchOrchestration() {
+	orchestration := test.createOrchestration()
+
+	watch, err := test.client.WatchOrchestration(context.TODO(), &pb.WatchOrchestrationRequest{OrchestrationId: orchestration.OrchestrationID})
+	test.Require().NoError(err)
+
+	rsp, err := watch.Recv()
+	test.Require().NoError(err)
+	test.Equal(pb.Orchestration_CREATED, rsp.Orchestration.Status)
+	test.Nil(rsp.Command)
+
+	// Notifications for messages that are gone are skipped
+	_, err = test.pgx.Exec(context.TODO(), `SELECT pg_notify('message_status', 'unknown')`)
+	test.Require().NoError(err)
+
+	_, err = test.pgx.Exec(context.TODO(), `UPDATE message SET status='COMPLETE' WHERE orchestration_step='start'`)
+	test.Require().NoError(err)
+
+	rsp, err = watch.Recv()
+	test.Require().NoError(err)
+	test.Equal(pb.Orchestration_RUNNING, rsp.Orchestration.Status)
+	test.Require().NotNil(rsp.Command)
+	test.Equal("start", rsp.Command.Method)
+}
+
+func (test *OrchestrationServerTest) TestResumeOrchestration() {
+	_, err := test.client.ResumeOrchestration(context.TODO(), &pb.ResumeOrchestrationRequest{OrchestrationId: "unknown"})
+	test.Require().Error(err)
+	test.Equal(codes.NotFound, status.Code(err))
+
+	orchestration := test.createOrchestration()
+
+	_, err = test.pgx.Exec(context.TODO(), `UPDATE message SET status='RECEIVED' WHERE orchestration_step='start'`)
+	test.Require().NoError(err)
+
+	_, err = test.client.ResumeOrchestration(context.TODO(), &pb.ResumeOrchestrationRequest{OrchestrationId: orchestration.OrchestrationID})
+	test.Require().Error(err)
+	test.Equal(codes.FailedPrecondition, status.Code(err))
+
+	_, err = test.pgx.Exec(context.TODO(), `UPDATE message SET status='ERROR' WHERE orchestration_step='start';
+UPDATE message SET status='CANCELED' WHERE orchestration_step='stop';
+UPDATE message SET status='COMPLETE' WHERE orchestration_fallback_step=true;`)
+	test.Require().NoError(err)
+
+	rsp, err := test.client.ResumeOrchestration(context.TODO(), &pb.ResumeOrchestrationRequest{OrchestrationId: orchestration.OrchestrationID})
+	test.Require().NoError(err)
+	test.Equal(pb.Orchestration_RUNNING, rsp.Orchestration.Status)
+	test.Equal("PUBLISHED", rsp.Orchestration.Steps[0].Commands[0].Statuses[0].Status)
+	test.Equal("CREATED", rsp.Orchestration.Steps[1].Commands[0].Statuses[0].Status)
+	test.Equal("CREATED", rsp.Orchestration.Steps[2].Commands[0].Statuses[0].Status)
+}
+
+func (test *OrchestrationServerTest) TestRetryOrchestrationStep() {
+	orchestration := test.createOrchestration()
+
+	_, err := test.pgx.Exec(context.TODO(), `UPDATE message SET status='COMPLETE'`)
+	test.Require().NoError(err)
+
+	rsp, err := test.client.RetryOrchestrationStep(context.TODO(), &pb.RetryOrchestrationStepRequest{
+		OrchestrationId: orchestration.OrchestrationID,
+		Step:            "stop",
+	})
+	test.Require().NoError(err)
+	test.Equal("COMPLETE", rsp.Orchestration.Steps[0].Commands[0].Statuses[0].Status)
+	test.Equal("PUBLISHED", rsp.Orchestration.Steps[1].Commands[0].Statuses[0].Status)
+}
+
+func (test *OrchestrationServerTest) TestCancelOrchestration() {
+	orchestration := test.createOrchestration()
+
+	rsp, err := test.client.CancelOrchestration(context.TODO(), &pb.CancelOrchestrationRequest{OrchestrationId: orchestration.OrchestrationID})
+	test.Require().NoError(err)
+	test.Equal(pb.Orchestration_CANCELED, rsp.Orchestration.Status)
+	test.Equal("CANCELED", rsp.Orchestration.Steps[0].Commands[0].Statuses[0].Status)
+	test.Equal("CANCELED", rsp.Orchestration.Steps[1].Commands[0].Statuses[0].Status)
+	test.Equal("PUBLISHED", rsp.Orchestration.Steps[2].Commands[0].Statuses[0].Status)
+}
+
+func (test *OrchestrationServerTest) TestMessages() {
+	msg, err := NewMessage("service", "instance", "start", map[string]string{"key": "value"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.repository.CreateMessage(context.TODO(), msg.(*message)))
+	test.Require().NoError(test.repository.UpdateMessageStatus(context.TODO(), msg.GetID(), MessageStatusError, errors.New("failed")))
+
+	test.Run("list", func() {
+		messageStatus := "ERROR"
+		rsp, err := test.messageClient.ListMessages(context.TODO(), &pb.ListMessagesRequest{Status: &messageStatus})
+		test.Require().NoError(err)
+		test.Require().Len(rsp.Commands, 1)
+		test.Equal(msg.GetID(), rsp.Commands[0].Id)
+		test.Equal("failed", rsp.Commands[0].Statuses[0].GetError())
+
+		method := "stop"
+		rsp, err = test.messageClient.ListMessages(context.TODO(), &pb.ListMessagesRequest{Method: &method})
+		test.Require().NoError(err)
+		test.Empty(rsp.Commands)
+	})
+	test.Run("get", func() {
+		_, err := test.messageClient.GetMessage(context.TODO(), &pb.GetMessageRequest{MessageId: "unknown"})
+		test.Equal(codes.NotFound, status.Code(err))
+
+		rsp, err := test.messageClient.GetMessage(context.TODO(), &pb.GetMessageRequest{MessageId: msg.GetID()})
+		test.Require().NoError(err)
+		test.JSONEq(`{"key": "value"}`, string(rsp.Command.Payload))
+		test.Len(rsp.Command.Statuses, 2)
+	})
+	test.Run("replay with new id", func() {
+		rsp, err := test.messageClient.ReplayMessage(context.TODO(), &pb.ReplayMessageRequest{MessageId: msg.GetID(), NewId: true})
+		test.Require().NoError(err)
+		test.NotEqual(msg.GetID(), rsp.Command.Id)
+		test.Equal("PUBLISHED", rsp.Command.Statuses[0].Status)
+		test.JSONEq(`{"key": "value"}`, string(rsp.Command.Payload))
+	})
+	test.Run("replay", func() {
+		rsp, err := test.messageClient.ReplayMessage(context.TODO(), &pb.ReplayMessageRequest{MessageId: msg.GetID()})
+		test.Require().NoError(err)
+		test.Equal(msg.GetID(), rsp.Command.Id)
+		test.Equal("PUBLISHED", rsp.Command.Statuses[0].Status)
+
+		_, err = test.messageClient.ReplayMessage(context.TODO(), &pb.ReplayMessageRequest{MessageId: msg.GetID()})
+		test.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+	test.Run("replay orchestration command", func() {
+		orchestration := test.createOrchestration()
+
+		_, err := test.pgx.Exec(context.TODO(), `UPDATE message SET status='ERROR' WHERE orchestration_step='start';
+UPDATE message SET status='CANCELED' WHERE orchestration_step='stop';
+UPDATE message SET status='COMPLETE' WHERE orchestration_fallback_step=true;`)
+		test.Require().NoError(err)
+
+		command := orchestration.Steps[0].Commands[0]
+		_, err = test.messageClient.ReplayMessage(context.TODO(), &pb.ReplayMessageRequest{MessageId: command.GetID(), NewId: true})
+		test.Equal(codes.FailedPrecondition, status.Code(err))
+
+		_, err = test.messageClient.ReplayMessage(context.TODO(), &pb.ReplayMessageRequest{MessageId: command.GetID()})
+		test.Require().NoError(err)
+
+		rsp, err := test.client.GetOrchestration(context.TODO(), &pb.GetOrchestrationRequest{OrchestrationId: orchestration.OrchestrationID})
+		test.Require().NoError(err)
+		test.Equal("PUBLISHED", rsp.Orchestration.Steps[0].Commands[0].Statuses[0].Status)
+		test.Equal("CREATED", rsp.Orchestration.Steps[1].Commands[0].Statuses[0].Status)
+		test.Equal("CREATED", rsp.Orchestration.Steps[2].Commands[0].Statuses[0].Status)
+	})
+}
+
+func (test *OrchestrationServerTest) TestDeadLetters() {
+	msg, err := NewMessage("service", "instance", "start", map[string]string{"key": "value"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.repository.CreateMessage(context.TODO(), msg.(*message)))
+	test.Require().NoError(test.repository.UpdateMessageStatus(context.TODO(), msg.GetID(), MessageStatusError, errors.New("failed")))
+	test.Require().NoError(test.repository.CreateDeadLetter(context.TODO(), msg.GetID(), 3, errors.New("failed")))
+
+	test.Run("list", func() {
+		rsp, err := test.messageClient.ListDeadLetters(context.TODO(), &pb.ListDeadLettersRequest{})
+		test.Require().NoError(err)
+		test.Require().Len(rsp.DeadLetters, 1)
+		test.Equal(msg.GetID(), rsp.DeadLetters[0].Command.Id)
+		test.Equal(int32(3), rsp.DeadLetters[0].Command.Attempts)
+		test.Equal("failed", rsp.DeadLetters[0].Error)
+	})
+	test.Run("redrive", func() {
+		rsp, err := test.messageClient.RedriveDeadLetter(context.TODO(), &pb.RedriveDeadLetterRequest{MessageId: msg.GetID()})
+		test.Require().NoError(err)
+		test.Equal(msg.GetID(), rsp.Command.Id)
+		test.Equal("PUBLISHED", rsp.Command.Statuses[0].Status)
+
+		list, err := test.messageClient.ListDeadLetters(context.TODO(), &pb.ListDeadLettersRequest{})
+		test.Require().NoError(err)
+		test.Empty(list.DeadLetters)
+
+		_, err = test.messageClient.RedriveDeadLetter(context.TODO(), &pb.RedriveDeadLetterRequest{MessageId: msg.GetID()})
+		test.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func (test *OrchestrationServerTest) TestSchedules() {
+	rsp, err := test.scheduleClient.ListSchedules(context.TODO(), &pb.ListSchedulesRequest{})
+	test.Require().NoError(err)
+	test.Empty(rsp.Schedules)
+
+	_, err = test.scheduleClient.CreateSchedule(context.TODO(), &pb.CreateScheduleRequest{
+		Name: "nightly", Cron: "not cron", Module: "finance", Component: "marketdata", Method: "ingest",
+	})
+	test.Require().Error(err)
+	test.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = test.scheduleClient.CreateSchedule(context.TODO(), &pb.CreateScheduleRequest{
+		Name: "nightly", Cron: "0 0 * * *", Module: "finance", Component: "marketdata", Method: "ingest",
+		Payload: []byte("not json"),
+	})
+	test.Require().Error(err)
+	test.Equal(codes.InvalidArgument, status.Code(err))
+
+	created, err := test.scheduleClient.CreateSchedule(context.TODO(), &pb.CreateScheduleRequest{
+		Name: "nightly", Cron: "0 0 * * *", Module: "finance", Component: "marketdata", Method: "ingest",
+		Payload: []byte(`{"symbols":["AAPL"]}`),
+	})
+	test.Require().NoError(err)
+	test.Equal("nightly", created.Schedule.Name)
+	test.NotNil(created.Schedule.NextRunAt)
+	test.Nil(created.Schedule.LastRunAt)
+
+	rsp, err = test.scheduleClient.ListSchedules(context.TODO(), &pb.ListSchedulesRequest{})
+	test.Require().NoError(err)
+	test.Require().Len(rsp.Schedules, 1)
+	test.JSONEq(`{"symbols":["AAPL"]}`, string(rsp.Schedules[0].Payload))
+
+	_, err = test.scheduleClient.DeleteSchedule(context.TODO(), &pb.DeleteScheduleRequest{Name: "nightly"})
+	test.Require().NoError(err)
+
+	_, err = test.scheduleClient.DeleteSchedule(context.TODO(), &pb.DeleteScheduleRequest{Name: "nightly"})
+	test.Require().Error(err)
+	test.Equal(codes.NotFound, status.Code(err))
+}
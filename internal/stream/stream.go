@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -29,11 +31,21 @@ type Handler interface {
 type Stream interface {
 	Unsubscribe() error
 	Publish(ctx context.Context, message Message) error
-	CommandSubscriber(component, method string, cb func(context.Context, Message) error) error
+	PublishTx(ctx context.Context, tx pgx.Tx, message Message) error
+	PublishAt(ctx context.Context, message Message, at time.Time) error
+	Schedule(ctx context.Context, name, spec string, message Message) error
+	CommandSubscriber(component, method string, cb func(context.Context, Message) error, options ...SubscriberOption) error
+	EventSubscriber(module, component, method string, cb func(context.Context, Message) error) error
 	RunOrchestration(ctx context.Context, orchestration *MessageOrchestration) error
 }
 
+// New connects to JetStream, with the memory backend there is nothing to connect to and
+// both the connection and the context are nil.
 func New() (*nats.Conn, nats.JetStreamContext, error) {
+	if environment.GetStreamBackend() == MemoryBackend {
+		return nil, nil, nil
+	}
+
 	natsConnect, err := nats.Connect(environment.GetNATSURL())
 	if err != nil {
 		return nil, nil, fmt.Errorf("error connecting to nats: %w", err)
@@ -44,10 +56,19 @@ func New() (*nats.Conn, nats.JetStreamContext, error) {
 		return nil, nil, fmt.Errorf("error connecting to jetstream: %w", err)
 	}
 
-	_, err = natsJetstream.AddStream(&nats.StreamConfig{
-		Name:     "foreverbull",
+	cfg := &nats.StreamConfig{
+		Name:     streamName,
 		Subjects: []string{"foreverbull.>"},
-	})
+		MaxAge:   environment.GetNATSMaxAge(),
+		MaxBytes: environment.GetNATSMaxBytes(),
+	}
+
+	_, err = natsJetstream.AddStream(cfg)
+	if errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		// Limits may have changed since the stream was created
+		_, err = natsJetstream.UpdateStream(cfg)
+	}
+
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating stream: %w", err)
 	}
@@ -55,13 +76,38 @@ func New() (*nats.Conn, nats.JetStreamContext, error) {
 	return natsConnect, natsJetstream, nil
 }
 
+const (
+	MemoryBackend = "memory"
+
+	streamName = "foreverbull"
+)
+
+var memoryBus = NewMemoryBus() //nolint: gochecknoglobals
+
+// NewStream creates the stream for a module on the backend selected by the environment,
+// with the memory backend every module in the process shares the same bus.
+func NewStream(jetstream nats.JetStreamContext, module string,
+	dependencies DependencyContainer, pool *pgxpool.Pool,
+) (Stream, error) {
+	if environment.GetStreamBackend() == MemoryBackend {
+		return NewMemoryStream(memoryBus, module, dependencies), nil
+	}
+
+	return NewNATSStream(jetstream, module, dependencies, pool)
+}
+
 type NATSStream struct {
 	module string
 
 	jt   nats.JetStreamContext
 	subs []*nats.Subscription
 
-	deps *dependencyContainer
+	retries   *retryTimers
+	running   *runningCommands
+	cancelSub *nats.Subscription
+
+	deps  *dependencyContainer
+	limit semaphore
 
 	repository repository
 }
@@ -69,19 +115,12 @@ type NATSStream struct {
 func NewNATSStream(jetstream nats.JetStreamContext, module string,
 	dependencies DependencyContainer, pool *pgxpool.Pool,
 ) (Stream, error) {
-	cfg := &nats.ConsumerConfig{
-		Name:       module,
-		Durable:    module,
-		MaxDeliver: 1,
-		AckPolicy:  nats.AckExplicitPolicy,
-	}
-
-	_, err := jetstream.AddConsumer("foreverbull", cfg)
-	if err != nil {
-		return nil, fmt.Errorf("error creating consumer: %w", err)
+	// Earlier versions created an unused consumer named after the module
+	if err := deleteLegacyConsumer(jetstream, module); err != nil {
+		return nil, err
 	}
 
-	_, err = pool.Exec(context.Background(), table)
+	_, err := pool.Exec(context.Background(), table)
 	if err != nil {
 		return nil, fmt.Errorf("error creating table: %w", err)
 	}
@@ -89,117 +128,226 @@ func NewNATSStream(jetstream nats.JetStreamContext, module string,
 		module:     module,
 		jt:         jetstream,
 		deps:       dependencies.(*dependencyContainer),
+		limit:      newSemaphore(environment.GetStreamConcurrency(module)),
 		repository: NewRepository(pool),
+		retries:    newRetryTimers(),
+		running:    newRunningCommands(),
 	}, nil
 }
 
-func (ns *NATSStream) CommandSubscriber(component, method string, cb func(context.Context, Message) error) error {
-	jtCb := func(natsMsg *nats.Msg) {
-		// For now we just ack the message
-		if err := natsMsg.Ack(); err != nil {
-			log.Err(err).Msg("error acknowledging message")
-		}
+func (ns *NATSStream) deadLetter(ctx context.Context, msg *message, cmdErr error) error {
+	err := ns.repository.CreateDeadLetter(ctx, *msg.ID, msg.Attempts, cmdErr)
+	if err != nil {
+		return fmt.Errorf("error creating dead letter: %w", err)
+	}
 
-		go func(natsMsg *nats.Msg) {
-			defer func() {
-				if r := recover(); r != nil {
-					log.Err(fmt.Errorf("panic: %v", r)).Stack().Str("component", component).Str("method", method).Msg("panic in command subscriber")
-				}
-			}()
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("error marshalling message: %w", err)
+	}
 
-			msg := &message{}
+	_, err = ns.jt.Publish(fmt.Sprintf("foreverbull.%s.%s.%s.dead_letter", msg.Module, msg.Component, msg.Method), payload)
+	if err != nil {
+		return fmt.Errorf("error publishing dead letter: %w", err)
+	}
 
-			err := json.Unmarshal(natsMsg.Data, &msg)
-			if err != nil {
-				log.Err(err).Msg("error unmarshalling message")
-			}
+	return nil
+}
 
-			if msg.ID == nil {
-				log.Error().Msg("message id is nil")
-				return
-			}
+// RedriveDeadLetter publishes a dead lettered message again with its attempts reset.
+func (ns *NATSStream) RedriveDeadLetter(ctx context.Context, messageID string) (*message, error) {
+	msg, err := ns.repository.RedriveDeadLetter(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("error redriving dead letter: %w", err)
+	}
 
-			ctx := context.Background()
+	if err = ns.Publish(ctx, msg); err != nil {
+		return nil, err
+	}
 
-			msg, err = ns.repository.UpdatePublishedAndGetMessage(ctx, *msg.ID)
-			if err != nil {
-				if errors.Is(err, pgx.ErrNoRows) {
-					log.Debug().Msg("message not found, probably already processed")
-				} else {
-					log.Err(err).Msg("error updating message status")
-				}
+	return ns.repository.GetMessage(ctx, messageID)
+}
+
+func (ns *NATSStream) CommandSubscriber(component, method string, cb func(context.Context, Message) error, options ...SubscriberOption) error {
+	subscription := newSubscriberOptions(options...)
+
+	if ns.cancelSub == nil {
+		var err error
+
+		ns.cancelSub, err = ns.jt.Subscribe("foreverbull.orchestration.*.cancel", func(natsMsg *nats.Msg) {
+			ns.running.cancelOrchestration(string(natsMsg.Data))
+		}, nats.DeliverNew())
+		if err != nil {
+			return fmt.Errorf("error subscribing to orchestration cancel: %w", err)
+		}
+
+		ns.subs = append(ns.subs, ns.cancelSub)
+	}
 
-				return
+	// handle returns nil once the outcome of the command is stored, failed commands are
+	// retried through the stored message. Errors are redelivered, non retryable errors are
+	// terminated.
+	handle := func(natsMsg *nats.Msg) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Err(fmt.Errorf("panic: %v", r)).Stack().Str("component", component).Str("method", method).Msg("panic in command subscriber")
+				err = NonRetryable(fmt.Errorf("panic: %v", r))
 			}
+		}()
 
-			log := log.With().Str("id", *msg.ID).Str("component", component).Str("method", method).Logger()
-			if msg.OrchestrationID != nil {
-				log = log.With().Str("id", *msg.ID).Str("Orchestration", *msg.OrchestrationName).Str("OrchestrationID", *msg.OrchestrationID).Str("OrchestrationStep", *msg.OrchestrationStep).Logger()
+		msg := &message{}
+
+		err = json.Unmarshal(natsMsg.Data, &msg)
+		if err != nil {
+			log.Err(err).Msg("error unmarshalling message")
+			return NonRetryable(fmt.Errorf("error unmarshalling message: %w", err))
+		}
+
+		if msg.ID == nil {
+			log.Error().Msg("message id is nil")
+			return NonRetryable(errors.New("message id is nil"))
+		}
+
+		ctx := context.Background()
+
+		msg, err = ns.repository.UpdatePublishedAndGetMessage(ctx, *msg.ID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				log.Debug().Msg("message not found, probably already processed")
+				return nil
 			}
 
-			log.Info().Msg("received command")
+			log.Err(err).Msg("error updating message status")
+
+			return err
+		}
+
+		log := log.With().Str("id", *msg.ID).Str("component", component).Str("method", method).Logger()
+		if msg.OrchestrationID != nil {
+			log = log.With().Str("id", *msg.ID).Str("Orchestration", *msg.OrchestrationName).Str("OrchestrationID", *msg.OrchestrationID).Str("OrchestrationStep", *msg.OrchestrationStep).Logger()
+		}
+
+		log.Info().Msg("received command")
 
-			msg.dependencyContainer = ns.deps
+		msg.dependencyContainer = ns.deps
 
-			err = cb(ctx, msg)
+		// The command context is only canceled when its orchestration is canceled, the
+		// handler may keep using it after it returns.
+		cmdCtx, cancel := context.WithCancel(ctx)
+		ns.running.add(*msg.ID, msg.GetOrchestrationID(), cancel)
+
+		err = cb(cmdCtx, msg)
+
+		ns.running.remove(*msg.ID)
+
+		if err != nil && errors.Is(cmdCtx.Err(), context.Canceled) {
+			log.Warn().Err(err).Msg("command canceled")
+
+			cmdErr := err
+			msg.setStatus(MessageStatusCanceled, cmdErr)
+
+			err = ns.repository.UpdateMessageStatus(ctx, *msg.ID, MessageStatusCanceled, cmdErr)
 			if err != nil {
-				log.Err(err).Msg("error executing command")
+				log.Err(err).Msg("error updating message status")
+				return err
+			}
 
-				err = ns.repository.UpdateMessageStatus(ctx, *msg.ID, MessageStatusError, err)
-				if err != nil {
-					log.Err(err).Msg("error updating message status")
-					return
-				}
-			} else {
-				log.Info().Msg("command completed successfully")
+			err = NonRetryable(cmdErr)
+		} else if err != nil {
+			if subscription.retryPolicy.ShouldRetry(msg.Attempts, err) {
+				backoff := subscription.retryPolicy.Backoff(msg.Attempts)
+				log.Warn().Err(err).Int("attempt", msg.Attempts).Dur("backoff", backoff).Msg("error executing command, retrying")
 
-				err = ns.repository.UpdateMessageStatus(ctx, *msg.ID, MessageStatusComplete, nil)
-				if err != nil {
+				if err := ns.repository.UpdateMessageRetry(ctx, *msg.ID, err, time.Now().Add(backoff)); err != nil {
 					log.Err(err).Msg("error updating message status")
-					return
+					return err
 				}
+
+				// The orchestration runner publishes the retry once due if this replica stops first
+				ns.retries.schedule(*msg.ID, backoff, func() {
+					claimed, err := ns.repository.ClaimAndPublish(context.Background(), *msg.ID, MessageStatusRetry,
+						func() error { return ns.publish(msg) })
+					if err != nil {
+						log.Err(err).Msg("error publishing retry")
+						return
+					}
+
+					if !claimed {
+						log.Debug().Msg("retry no longer scheduled, probably canceled")
+					}
+				})
+
+				return nil
 			}
 
-			payload, err := json.Marshal(msg)
+			log.Err(err).Int("attempt", msg.Attempts).Msg("error executing command")
+
+			cmdErr := err
+			msg.setStatus(MessageStatusError, cmdErr)
+
+			err = ns.repository.UpdateMessageStatus(ctx, *msg.ID, MessageStatusError, cmdErr)
 			if err != nil {
-				log.Err(err).Msg("error marshalling message")
-				return
+				log.Err(err).Msg("error updating message status")
+				return err
+			}
+
+			if err := ns.deadLetter(ctx, msg, cmdErr); err != nil {
+				log.Err(err).Msg("error dead lettering message")
 			}
 
-			_, err = ns.jt.Publish(fmt.Sprintf("foreverbull.%s.%s.%s.event", ns.module, component, method), payload)
+			err = NonRetryable(cmdErr)
+		} else {
+			log.Info().Msg("command completed successfully")
+			msg.setStatus(MessageStatusComplete, nil)
+
+			err = ns.repository.UpdateMessageStatus(ctx, *msg.ID, MessageStatusComplete, nil)
 			if err != nil {
-				log.Err(err).Msg("error publishing event")
-				return
+				log.Err(err).Msg("error updating message status")
+				return err
 			}
-		}(natsMsg)
+		}
+
+		payload, marshalErr := json.Marshal(msg)
+		if marshalErr != nil {
+			log.Err(marshalErr).Msg("error marshalling message")
+			return NonRetryable(marshalErr)
+		}
+
+		if _, pubErr := ns.jt.Publish(eventSubject(ns.module, component, method), payload); pubErr != nil {
+			log.Err(pubErr).Msg("error publishing event")
+			return pubErr
+		}
+
+		return err
 	}
 
-	opts := []nats.SubOpt{
-		nats.MaxDeliver(1),
-		nats.Durable(fmt.Sprintf("foreverbull-%s-%s-%s", ns.module, component, method)),
+	concurrency := subscription.concurrency
+	if concurrency <= 0 || concurrency > cap(ns.limit) {
+		concurrency = cap(ns.limit)
 	}
-	deliverPolicy := environment.GetNATSDeliveryPolicy()
 
-	switch deliverPolicy {
-	case "all":
-		opts = append(opts, nats.DeliverAll())
-	case "last":
-		opts = append(opts, nats.DeliverLast())
-	default:
-		return fmt.Errorf("unknown delivery policy: %s", environment.GetNATSDeliveryPolicy())
+	subject := fmt.Sprintf("foreverbull.%s.%s.%s.command", ns.module, component, method)
+	durable := fmt.Sprintf("foreverbull-%s-%s-%s", ns.module, component, method)
+
+	if err := ns.ensurePullConsumer(subject, durable, concurrency, commandMaxDeliver); err != nil {
+		return err
 	}
 
-	sub, err := ns.jt.Subscribe(fmt.Sprintf("foreverbull.%s.%s.%s.command", ns.module, component, method), jtCb, opts...)
+	sub, err := ns.jt.PullSubscribe(subject, durable, nats.Bind(streamName, durable))
 	if err != nil {
 		return fmt.Errorf("error subscribing to jetstream: %w", err)
 	}
 
+	go ns.consume(sub, newSemaphore(concurrency), handle)
+
 	ns.subs = append(ns.subs, sub)
 
 	return nil
 }
 
 func (ns *NATSStream) Unsubscribe() error {
+	ns.retries.stop()
+
 	for _, sub := range ns.subs {
 		if !sub.IsValid() {
 			continue
@@ -214,6 +362,9 @@ func (ns *NATSStream) Unsubscribe() error {
 	return nil
 }
 
+// Publish stores the message and publishes it to the stream, a message stored with
+// PublishTx is only published. The message is only marked as published once the stream
+// has acknowledged it, if publishing fails the relay picks it up later.
 func (ns *NATSStream) Publish(ctx context.Context, msg Message) error {
 	m, isMsg := msg.(*message)
 	if !isMsg {
@@ -225,16 +376,45 @@ func (ns *NATSStream) Publish(ctx context.Context, msg Message) error {
 		}
 	}
 
-	topic := fmt.Sprintf("foreverbull.%s.%s.%s.command", m.Module, m.Component, m.Method)
+	if err := ns.publish(m); err != nil {
+		return err
+	}
 
-	payload, err := json.Marshal(m)
+	err := ns.repository.MarkPublished(ctx, *m.ID)
 	if err != nil {
-		return fmt.Errorf("error marshalling message: %w", err)
+		return fmt.Errorf("error updating message status: %w", err)
 	}
 
-	err = ns.repository.UpdateMessageStatus(ctx, *m.ID, MessageStatusPublished, err)
+	return nil
+}
+
+// PublishTx stores the message as part of the given transaction. Once the transaction is
+// committed the caller publishes the message with Publish, if that fails or never happens
+// the relay publishes it. A rolled back message is never published.
+func (ns *NATSStream) PublishTx(ctx context.Context, tx pgx.Tx, msg Message) error {
+	m, isMsg := msg.(*message)
+	if !isMsg {
+		return fmt.Errorf("invalid message")
+	}
+
+	if m.ID != nil {
+		return errors.New("message is already stored")
+	}
+
+	repository := ns.repository.withTx(tx)
+	if err := repository.CreateMessage(ctx, m); err != nil {
+		return fmt.Errorf("error creating message: %w", err)
+	}
+
+	return nil
+}
+
+func (ns *NATSStream) publish(m *message) error {
+	topic := fmt.Sprintf("foreverbull.%s.%s.%s.command", m.Module, m.Component, m.Method)
+
+	payload, err := json.Marshal(m)
 	if err != nil {
-		return fmt.Errorf("error updating message status: %w", err)
+		return fmt.Errorf("error marshalling message: %w", err)
 	}
 
 	_, err = ns.jt.Publish(topic, payload)
@@ -245,8 +425,27 @@ func (ns *NATSStream) Publish(ctx context.Context, msg Message) error {
 	return nil
 }
 
+// relayBatchSize is the maximum number of messages relayed in one pass.
+const relayBatchSize = 100
+
+// relayCreated publishes messages that were stored but never published, either because
+// they were created in a transaction or because publishing them failed. Subscribers only
+// process a message once, so a message published twice is harmless.
+func (ns *NATSStream) relayCreated(ctx context.Context, createdBefore time.Time) (int, error) {
+	relayed, err := ns.repository.RelayCreated(ctx, createdBefore, relayBatchSize, ns.publish)
+	if err != nil {
+		return 0, fmt.Errorf("error relaying created messages: %w", err)
+	}
+
+	return relayed, nil
+}
+
 func (ns *NATSStream) RunOrchestration(ctx context.Context, orchestration *MessageOrchestration) error {
-	for _, step := range orchestration.Steps {
+	steps := make([]MessageOrchestrationStep, 0, len(orchestration.Steps)*2)
+	steps = append(steps, orchestration.Steps...)
+	steps = append(steps, orchestration.compensations()...)
+
+	for _, step := range steps {
 		for _, cmd := range step.Commands {
 			msg, isMsg := cmd.(*message)
 			if !isMsg {
@@ -294,13 +493,107 @@ func (ns *NATSStream) RunOrchestration(ctx context.Context, orchestration *Messa
 		}
 	}
 
-	commands, err := ns.repository.GetNextOrchestrationCommands(ctx, orchestration.OrchestrationID, -1)
+	return ns.continueOrchestration(ctx, orchestration.OrchestrationID)
+}
+
+func (ns *NATSStream) continueOrchestration(ctx context.Context, orchestrationID string) error {
+	commands, err := ns.repository.GetNextOrchestrationCommands(ctx, orchestrationID)
 	if err != nil {
-		return fmt.Errorf("error getting latest unpublished orchestration step commands: %w", err)
+		return fmt.Errorf("error getting next orchestration commands: %w", err)
 	}
 
+	return ns.publishOrchestrationCommands(ctx, commands)
+}
+
+var ErrOrchestrationMessage = errors.New("orchestration commands can only be reset")
+
+// ReplayMessage publishes a finished message again, either as a copy under a new id or by
+// resetting the message itself. A replayed orchestration command continues the orchestration
+// once it completes.
+func (ns *NATSStream) ReplayMessage(ctx context.Context, messageID string, newID bool) (*message, error) {
+	if !newID {
+		msg, err := ns.repository.ResetMessage(ctx, messageID)
+		if err != nil {
+			return nil, fmt.Errorf("error resetting message: %w", err)
+		}
+
+		if err = ns.Publish(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		return ns.repository.GetMessage(ctx, messageID)
+	}
+
+	original, err := ns.repository.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting message: %w", err)
+	}
+
+	if original.OrchestrationID != nil {
+		return nil, ErrOrchestrationMessage
+	}
+
+	msg := &message{
+		Module:        original.Module,
+		Component:     original.Component,
+		Method:        original.Method,
+		Payload:       original.Payload,
+		SchemaVersion: original.SchemaVersion,
+	}
+
+	if err = ns.Publish(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return ns.repository.GetMessage(ctx, *msg.ID)
+}
+
+// ResumeOrchestration runs failed and canceled commands of a finished orchestration again,
+// continuing from where it stopped with the same orchestration id.
+func (ns *NATSStream) ResumeOrchestration(ctx context.Context, orchestrationID string) error {
+	err := ns.repository.ResetOrchestration(ctx, orchestrationID, nil)
+	if err != nil {
+		return fmt.Errorf("error resetting orchestration: %w", err)
+	}
+
+	return ns.continueOrchestration(ctx, orchestrationID)
+}
+
+// RetryOrchestrationStep runs every command in the step again, together with commands
+// that failed or were canceled.
+func (ns *NATSStream) RetryOrchestrationStep(ctx context.Context, orchestrationID, step string) error {
+	err := ns.repository.ResetOrchestration(ctx, orchestrationID, &step)
+	if err != nil {
+		return fmt.Errorf("error resetting orchestration step: %w", err)
+	}
+
+	return ns.continueOrchestration(ctx, orchestrationID)
+}
+
+// CancelOrchestration stops commands that have not started, signals running commands to
+// stop through their context and runs the fallback once nothing is in flight.
+func (ns *NATSStream) CancelOrchestration(ctx context.Context, orchestrationID string) error {
+	err := ns.repository.CancelOrchestration(ctx, orchestrationID)
+	if err != nil {
+		return fmt.Errorf("error canceling orchestration: %w", err)
+	}
+
+	_, err = ns.jt.Publish(fmt.Sprintf("foreverbull.orchestration.%s.cancel", orchestrationID), []byte(orchestrationID))
+	if err != nil {
+		return fmt.Errorf("error publishing cancel: %w", err)
+	}
+
+	return ns.continueOrchestration(ctx, orchestrationID)
+}
+
+// publishOrchestrationCommands claims each command while publishing it, parallel branches
+// completing at the same time would otherwise both publish the step joining them. Commands
+// that fail to publish stay created and are published again by relayOrchestrations.
+func (ns *NATSStream) publishOrchestrationCommands(ctx context.Context, commands *[]message) error {
 	for _, cmd := range *commands {
-		err = ns.Publish(ctx, &cmd)
+		_, err := ns.repository.ClaimAndPublish(ctx, *cmd.ID, MessageStatusCreated, func() error {
+			return ns.publish(&cmd)
+		})
 		if err != nil {
 			return fmt.Errorf("error publishing command: %w", err)
 		}
@@ -308,3 +601,76 @@ func (ns *NATSStream) RunOrchestration(ctx context.Context, orchestration *Messa
 
 	return nil
 }
+
+// relayOrchestrations continues orchestrations that have created commands but nothing in
+// flight since before the given time, their publisher failed or stopped before publishing
+// the next commands. Orchestrations with nothing left to run get their created commands
+// canceled.
+func (ns *NATSStream) relayOrchestrations(ctx context.Context, before time.Time) (int, error) {
+	orchestrations, err := ns.repository.ListStalledOrchestrations(ctx, before, relayBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("error listing stalled orchestrations: %w", err)
+	}
+
+	for i, orchestrationID := range *orchestrations {
+		commands, err := ns.repository.GetNextOrchestrationCommands(ctx, orchestrationID)
+		if err != nil {
+			return i, fmt.Errorf("error getting next orchestration commands: %w", err)
+		}
+
+		if len(*commands) == 0 {
+			if err = ns.repository.MarkAllCreatedAsCanceled(ctx, orchestrationID); err != nil {
+				return i, fmt.Errorf("error marking all created as canceled: %w", err)
+			}
+
+			continue
+		}
+
+		if err = ns.publishOrchestrationCommands(ctx, commands); err != nil {
+			return i, err
+		}
+	}
+
+	return len(*orchestrations), nil
+}
+
+type runningCommand struct {
+	orchestrationID string
+	cancel          context.CancelFunc
+}
+
+type runningCommands struct {
+	lock     sync.Mutex
+	commands map[string]runningCommand
+}
+
+func newRunningCommands() *runningCommands {
+	return &runningCommands{
+		commands: make(map[string]runningCommand),
+	}
+}
+
+func (rc *runningCommands) add(id, orchestrationID string, cancel context.CancelFunc) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	rc.commands[id] = runningCommand{orchestrationID: orchestrationID, cancel: cancel}
+}
+
+func (rc *runningCommands) remove(id string) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	delete(rc.commands, id)
+}
+
+func (rc *runningCommands) cancelOrchestration(orchestrationID string) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	for _, command := range rc.commands {
+		if command.orchestrationID != "" && command.orchestrationID == orchestrationID {
+			command.cancel()
+		}
+	}
+}
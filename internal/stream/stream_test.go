@@ -19,6 +19,7 @@ type NatsStreamTest struct {
 
 	nc     *nats.Conn
 	jt     nats.JetStreamContext
+	pool   *pgxpool.Pool
 	stream NATSStream
 }
 
@@ -30,6 +31,7 @@ func (test *NatsStreamTest) SetupTest() {
 
 	pool, err := pgxpool.New(context.Background(), environment.GetPostgresURL())
 	test.Require().NoError(err)
+	test.pool = pool
 
 	err = RecreateTables(context.Background(), pool)
 	test.Require().NoError(err)
@@ -43,6 +45,7 @@ func (test *NatsStreamTest) SetupTest() {
 
 	test.Require().NoError(test.stream.CommandSubscriber("return", "nil", ReturnNil))
 	test.Require().NoError(test.stream.CommandSubscriber("return", "err", ReturnErr))
+	test.Require().NoError(test.stream.CommandSubscriber("wait", "canceled", WaitCanceled))
 }
 
 func (test *NatsStreamTest) TearDownTest() {
@@ -70,6 +73,28 @@ func ReturnErr(ctx context.Context, message Message) error {
 	return errors.New("test error")
 }
 
+func WaitCanceled(ctx context.Context, message Message) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (test *NatsStreamTest) TestStreamLimits() {
+	info, err := test.jt.StreamInfo(streamName)
+	test.Require().NoError(err)
+	test.Equal(environment.GetNATSMaxAge(), info.Config.MaxAge)
+	test.Equal(environment.GetNATSMaxBytes(), info.Config.MaxBytes)
+
+	test.T().Setenv(environment.NatsMaxAge, "1h")
+
+	nc, _, err := New()
+	test.Require().NoError(err)
+	defer nc.Close()
+
+	info, err = test.jt.StreamInfo(streamName)
+	test.Require().NoError(err)
+	test.Equal(time.Hour, info.Config.MaxAge)
+}
+
 func (test *NatsStreamTest) TestPubSub() {
 	type TestCase struct {
 		name           string
@@ -109,7 +134,7 @@ func (test *NatsStreamTest) TestRunOrchestration() {
 				return test.jt
 			},
 			func() *pgxpool.Pool {
-				return test.stream.repository.db
+				return test.pool
 			},
 		),
 		OrchestrationLifecycle,
@@ -172,6 +197,342 @@ func (test *NatsStreamTest) TestRunOrchestration() {
 		test.Require().NoError(err)
 		test.Equal(MessageStatusComplete, msg.StatusHistory[0].Status)
 	})
+	test.Run("resume orchestration", func() {
+		msg1, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 1})
+		test.Require().NoError(err)
+		msg2, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 2})
+		test.Require().NoError(err)
+		msg3, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 3})
+		test.Require().NoError(err)
+
+		orchestration := NewMessageOrchestration("test orchestration")
+		orchestration.AddStep("step1", []Message{msg1})
+		orchestration.AddStep("step2", []Message{msg2})
+		orchestration.SettFallback([]Message{msg3})
+
+		for _, step := range orchestration.Steps {
+			for _, cmd := range step.Commands {
+				test.Require().NoError(test.stream.repository.CreateMessage(context.Background(), cmd.(*message)))
+			}
+		}
+		test.Require().NoError(test.stream.repository.CreateMessage(context.Background(), msg3.(*message)))
+		test.Require().NoError(test.stream.repository.UpdateMessageStatus(context.Background(), msg1.GetID(), MessageStatusError, errors.New("failed")))
+		test.Require().NoError(test.stream.repository.UpdateMessageStatus(context.Background(), msg2.GetID(), MessageStatusCanceled, nil))
+		test.Require().NoError(test.stream.repository.UpdateMessageStatus(context.Background(), msg3.GetID(), MessageStatusComplete, nil))
+
+		err = test.stream.ResumeOrchestration(context.Background(), orchestration.OrchestrationID)
+		test.Require().NoError(err)
+
+		time.Sleep(time.Second / 2)
+
+		msg, err := test.stream.repository.GetMessage(context.Background(), msg1.GetID())
+		test.Require().NoError(err)
+		test.Equal(MessageStatusComplete, msg.StatusHistory[0].Status)
+		msg, err = test.stream.repository.GetMessage(context.Background(), msg2.GetID())
+		test.Require().NoError(err)
+		test.Equal(MessageStatusComplete, msg.StatusHistory[0].Status)
+		msg, err = test.stream.repository.GetMessage(context.Background(), msg3.GetID())
+		test.Require().NoError(err)
+		test.Equal(MessageStatusCanceled, msg.StatusHistory[0].Status)
+	})
+	test.Run("cancel orchestration", func() {
+		msg1, err := NewMessage("test", "wait", "canceled", TestPayload{Name: "test", Number: 1})
+		test.Require().NoError(err)
+		msg2, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 2})
+		test.Require().NoError(err)
+		msg3, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 3})
+		test.Require().NoError(err)
+
+		orchestration := NewMessageOrchestration("test orchestration")
+		orchestration.AddStep("step1", []Message{msg1})
+		orchestration.AddStep("step2", []Message{msg2})
+		orchestration.SettFallback([]Message{msg3})
+
+		err = test.stream.RunOrchestration(context.Background(), orchestration)
+		test.Require().NoError(err)
+
+		time.Sleep(time.Second / 2)
+
+		err = test.stream.CancelOrchestration(context.Background(), orchestration.OrchestrationID)
+		test.Require().NoError(err)
+
+		time.Sleep(time.Second / 2)
+
+		msg, err := test.stream.repository.GetMessage(context.Background(), msg1.GetID())
+		test.Require().NoError(err)
+		test.Equal(MessageStatusCanceled, msg.StatusHistory[0].Status)
+		msg, err = test.stream.repository.GetMessage(context.Background(), msg2.GetID())
+		test.Require().NoError(err)
+		test.Equal(MessageStatusCanceled, msg.StatusHistory[0].Status)
+		msg, err = test.stream.repository.GetMessage(context.Background(), msg3.GetID())
+		test.Require().NoError(err)
+		test.Equal(MessageStatusComplete, msg.StatusHistory[0].Status)
+	})
 
 	test.NoError(app.Stop(context.Background()))
 }
+
+func (test *NatsStreamTest) TestConcurrency() {
+	probe := &concurrencyProbe{}
+	test.Require().NoError(test.stream.CommandSubscriber("limited", "command", probe.handler, WithConcurrency(2)))
+
+	for range 6 {
+		msg, err := NewMessage("test", "limited", "command", nil)
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+	}
+
+	test.Eventually(func() bool { return probe.done.Load() == 6 }, time.Second*5, time.Millisecond*50)
+	test.Equal(int32(2), probe.max.Load())
+
+	info, err := test.jt.ConsumerInfo(streamName, "foreverbull-test-limited-command")
+	test.Require().NoError(err)
+	test.Equal(2, info.Config.MaxAckPending)
+}
+
+func (test *NatsStreamTest) TestPushConsumerRecreated() {
+	_, err := test.jt.AddConsumer(streamName, &nats.ConsumerConfig{
+		Durable:        "foreverbull-test-legacy-command",
+		DeliverSubject: "legacy.deliver",
+		FilterSubject:  "foreverbull.test.legacy.command.command",
+		AckPolicy:      nats.AckExplicitPolicy,
+		MaxDeliver:     1,
+	})
+	test.Require().NoError(err)
+
+	test.Require().NoError(test.stream.CommandSubscriber("legacy", "command", ReturnNil))
+
+	info, err := test.jt.ConsumerInfo(streamName, "foreverbull-test-legacy-command")
+	test.Require().NoError(err)
+	test.Empty(info.Config.DeliverSubject)
+	test.Equal(commandMaxDeliver, info.Config.MaxDeliver)
+
+	msg, err := NewMessage("test", "legacy", "command", nil)
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+	test.Eventually(func() bool {
+		stored, err := test.stream.repository.GetMessage(context.Background(), msg.GetID())
+		return err == nil && stored.StatusHistory[0].Status == MessageStatusComplete
+	}, time.Second*5, time.Millisecond*50)
+}
+
+func (test *NatsStreamTest) TestContextAfterReturn() {
+	ctxErr := make(chan error, 1)
+	handler := func(ctx context.Context, message Message) error {
+		go func() {
+			time.Sleep(time.Second / 4)
+			ctxErr <- ctx.Err()
+		}()
+
+		return nil
+	}
+	test.Require().NoError(test.stream.CommandSubscriber("background", "command", handler))
+
+	msg, err := NewMessage("test", "background", "command", nil)
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+	select {
+	case err := <-ctxErr:
+		test.NoError(err)
+	case <-time.After(time.Second * 5):
+		test.Fail("handler did not run")
+	}
+}
+
+func (test *NatsStreamTest) TestReplicas() {
+	replica, err := NewNATSStream(test.jt, "test", NewDependencyContainer(), test.pool)
+	test.Require().NoError(err)
+	defer func() { test.NoError(replica.Unsubscribe()) }()
+
+	probe := &concurrencyProbe{}
+	test.Require().NoError(test.stream.CommandSubscriber("replicated", "command", probe.handler))
+	test.Require().NoError(replica.CommandSubscriber("replicated", "command", probe.handler))
+
+	for range 6 {
+		msg, err := NewMessage("test", "replicated", "command", nil)
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+	}
+
+	test.Eventually(func() bool { return probe.done.Load() == 6 }, time.Second*5, time.Millisecond*50)
+	time.Sleep(time.Second / 2)
+	test.Equal(int32(6), probe.done.Load())
+}
+
+func (test *NatsStreamTest) TestEventSubscriber() {
+	other, err := NewNATSStream(test.jt, "other", NewDependencyContainer(), test.pool)
+	test.Require().NoError(err)
+	defer func() { test.NoError(other.Unsubscribe()) }()
+
+	events := make(chan Message, 1)
+	var failed bool
+	test.Require().NoError(other.EventSubscriber("test", "return", "nil", func(ctx context.Context, msg Message) error {
+		if !failed {
+			failed = true
+			return errors.New("redeliver")
+		}
+		events <- msg
+		return nil
+	}))
+
+	msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+	test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+	select {
+	case event := <-events:
+		test.Equal(msg.GetID(), event.GetID())
+	case <-time.After(time.Second * 5):
+		test.Fail("event not received")
+	}
+}
+
+func (test *NatsStreamTest) TestPublishTx() {
+	test.Run("committed", func() {
+		msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+
+		tx, err := test.pool.Begin(context.Background())
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.PublishTx(context.Background(), tx, msg))
+
+		relayed, err := test.stream.relayCreated(context.Background(), time.Now())
+		test.Require().NoError(err)
+		test.Equal(0, relayed)
+
+		test.Require().NoError(tx.Commit(context.Background()))
+		test.Require().NoError(test.stream.Publish(context.Background(), msg))
+
+		test.Eventually(func() bool {
+			m, err := test.stream.repository.GetMessage(context.Background(), msg.GetID())
+			test.Require().NoError(err)
+			return m.StatusHistory[0].Status == MessageStatusComplete
+		}, time.Second*2, time.Millisecond*50)
+
+		relayed, err = test.stream.relayCreated(context.Background(), time.Now())
+		test.Require().NoError(err)
+		test.Equal(0, relayed)
+	})
+	test.Run("relayed", func() {
+		msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+
+		tx, err := test.pool.Begin(context.Background())
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.PublishTx(context.Background(), tx, msg))
+		test.Require().NoError(tx.Commit(context.Background()))
+
+		// The publisher stopped before publishing
+		relayed, err := test.stream.relayCreated(context.Background(), time.Now())
+		test.Require().NoError(err)
+		test.Equal(1, relayed)
+
+		test.Eventually(func() bool {
+			m, err := test.stream.repository.GetMessage(context.Background(), msg.GetID())
+			test.Require().NoError(err)
+			return m.StatusHistory[0].Status == MessageStatusComplete
+		}, time.Second*2, time.Millisecond*50)
+	})
+	test.Run("rolled back", func() {
+		msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+		test.Require().NoError(err)
+
+		tx, err := test.pool.Begin(context.Background())
+		test.Require().NoError(err)
+		test.Require().NoError(test.stream.PublishTx(context.Background(), tx, msg))
+		test.Require().NoError(tx.Rollback(context.Background()))
+
+		relayed, err := test.stream.relayCreated(context.Background(), time.Now())
+		test.Require().NoError(err)
+		test.Equal(0, relayed)
+
+		m, err := test.stream.repository.GetMessage(context.Background(), msg.GetID())
+		test.Require().NoError(err)
+		test.Empty(m.StatusHistory)
+	})
+}
+
+func (test *NatsStreamTest) TestRelayOrchestrations() {
+	msg1, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 1})
+	test.Require().NoError(err)
+	msg2, err := NewMessage("test", "return", "nil", TestPayload{Name: "test", Number: 2})
+	test.Require().NoError(err)
+
+	orchestration := NewMessageOrchestration("relay")
+	orchestration.AddStep("step1", []Message{msg1})
+	orchestration.SettFallback([]Message{msg2})
+
+	// The runner stopped after storing the orchestration, before publishing the first step
+	for _, msg := range []Message{msg1, msg2} {
+		test.Require().NoError(test.stream.repository.CreateMessage(context.Background(), msg.(*message)))
+	}
+
+	relayed, err := test.stream.relayOrchestrations(context.Background(), time.Now().Add(-time.Hour))
+	test.Require().NoError(err)
+	test.Equal(0, relayed)
+
+	relayed, err = test.stream.relayOrchestrations(context.Background(), time.Now())
+	test.Require().NoError(err)
+	test.Equal(1, relayed)
+
+	test.Eventually(func() bool {
+		m, err := test.stream.repository.GetMessage(context.Background(), msg1.GetID())
+		test.Require().NoError(err)
+		return m.StatusHistory[0].Status == MessageStatusComplete
+	}, time.Second*2, time.Millisecond*50)
+
+	// Nothing is left to run, the fallback is canceled
+	relayed, err = test.stream.relayOrchestrations(context.Background(), time.Now())
+	test.Require().NoError(err)
+	test.Equal(1, relayed)
+
+	m, err := test.stream.repository.GetMessage(context.Background(), msg2.GetID())
+	test.Require().NoError(err)
+	test.Equal(MessageStatusCanceled, m.StatusHistory[0].Status)
+
+	relayed, err = test.stream.relayOrchestrations(context.Background(), time.Now())
+	test.Require().NoError(err)
+	test.Equal(0, relayed)
+}
+
+func (test *NatsStreamTest) TestPublishAt() {
+	msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+
+	publishAt := time.Now().Add(time.Minute)
+	test.Require().NoError(test.stream.PublishAt(context.Background(), msg, publishAt))
+
+	stored, err := test.stream.repository.GetMessage(context.Background(), msg.GetID())
+	test.Require().NoError(err)
+	test.Equal(MessageStatusScheduled, stored.StatusHistory[0].Status)
+
+	test.Require().NoError(test.stream.publishDue(context.Background(), time.Now()))
+	stored, err = test.stream.repository.GetMessage(context.Background(), msg.GetID())
+	test.Require().NoError(err)
+	test.Equal(MessageStatusScheduled, stored.StatusHistory[0].Status)
+
+	test.Require().NoError(test.stream.publishDue(context.Background(), publishAt))
+	test.Eventually(func() bool {
+		stored, err := test.stream.repository.GetMessage(context.Background(), msg.GetID())
+		test.Require().NoError(err)
+		return stored.StatusHistory[0].Status == MessageStatusComplete
+	}, time.Second*2, time.Millisecond*50)
+}
+
+func (test *NatsStreamTest) TestSchedule() {
+	msg, err := NewMessage("test", "return", "nil", TestPayload{Name: "test"})
+	test.Require().NoError(err)
+
+	test.Require().Error(test.stream.Schedule(context.Background(), "invalid", "* * *", msg))
+	test.Require().NoError(test.stream.Schedule(context.Background(), "every minute", "* * * * *", msg))
+
+	schedules, err := test.stream.repository.ListSchedules(context.Background())
+	test.Require().NoError(err)
+	test.Require().Len(*schedules, 1)
+
+	test.Require().NoError(test.stream.publishDue(context.Background(), (*schedules)[0].NextRunAt))
+	schedules, err = test.stream.repository.ListSchedules(context.Background())
+	test.Require().NoError(err)
+	test.Require().NotNil((*schedules)[0].LastRunAt)
+}
@@ -37,7 +37,9 @@ func WorkerSimulator(t *testing.T, functions ...*WorkerFunction) (*pb.Algorithm,
 		})
 	}
 
-	callbacks := make(map[string]func(*pb.WorkerRequest) *pb.WorkerResponse)
+	callbacks := map[string]func(*pb.WorkerRequest) *pb.WorkerResponse{
+		"heartbeat": Example,
+	}
 	for _, f := range functions {
 		callbacks[f.Name] = f.CB
 	}
@@ -61,7 +63,12 @@ func WorkerSimulator(t *testing.T, functions ...*WorkerFunction) (*pb.Algorithm,
 			rsp := cb(&req)
 			data, err := proto.Marshal(rsp)
 			require.NoError(t, err, "failed to marshal response data")
-			require.NoError(t, socket.Send(data), "failed to send response")
+			err = socket.Send(data)
+			if err != nil && err.Error() == "object closed" {
+				break
+			}
+
+			require.NoError(t, err, "failed to send response")
 		}
 	}
 
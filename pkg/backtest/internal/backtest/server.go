@@ -2,15 +2,23 @@ package backtest
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/lhjnilsson/foreverbull/internal/ports"
 	"github.com/lhjnilsson/foreverbull/internal/postgres"
+	"github.com/lhjnilsson/foreverbull/internal/socket"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/engine"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/repository"
 	backtest_pb "github.com/lhjnilsson/foreverbull/pkg/pb/backtest"
+	service_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
 	"github.com/lhjnilsson/foreverbull/pkg/service/worker"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type grpcSessionServer struct {
@@ -23,23 +31,49 @@ type grpcSessionServer struct {
 	backtestSession engine.EngineSession
 	wp              worker.Pool
 	server          *grpc.Server
+	publisher       socket.Publisher
+	credentials     *socket.Credentials
+	broker          *worker.Broker
 
-	activity chan bool
+	activity  chan bool
+	closeOnce sync.Once
 }
 
 const (
 	ActivityBufferSize = 5
 )
 
+// NewGRPCSessionServer creates the session server, broker is used for executions that
+// ask for the GRPC worker transport and may be nil when it is not served. release closes
+// the worker pool and publisher of the session and must be called once the session ends,
+// whether it was stopped or timed out.
 func NewGRPCSessionServer(session *backtest_pb.Session, database postgres.Query,
-	backtest engine.Engine,
-) (*grpc.Server, <-chan bool, error) {
+	backtest engine.Engine, broker *worker.Broker,
+) (*grpc.Server, <-chan bool, func(), error) {
 	grpcServer := grpc.NewServer()
 
 	backtestSession, err := backtest.NewSession(context.TODO(), session)
 	if err != nil {
 		log.Error().Err(err).Msg("fail to create zipline session")
-		return nil, nil, fmt.Errorf("error creating session: %w", err)
+		return nil, nil, nil, fmt.Errorf("error creating session: %w", err)
+	}
+
+	// Worker sockets and the publisher share the credentials of the session
+	credentials, err := socket.NewCredentials(worker.PoolServerName, worker.CredentialsValidity)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error creating credentials: %w", err)
+	}
+
+	serverTLS, err := credentials.ServerTLS()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error creating tls config: %w", err)
+	}
+
+	publisher, err := socket.NewPublisher("0.0.0.0", 0,
+		socket.WithOwner(ports.Owner{Kind: ports.Session, ID: session.Id}), socket.WithTLS(serverTLS))
+	if err != nil {
+		log.Error().Err(err).Msg("fail to create broadcast publisher")
+		return nil, nil, nil, fmt.Errorf("error creating publisher: %w", err)
 	}
 
 	activity := make(chan bool, ActivityBufferSize)
@@ -49,11 +83,49 @@ func NewGRPCSessionServer(session *backtest_pb.Session, database postgres.Query,
 		backtest:        backtest,
 		backtestSession: backtestSession,
 		server:          grpcServer,
+		publisher:       publisher,
+		credentials:     credentials,
+		broker:          broker,
 		activity:        activity,
 	}
 	backtest_pb.RegisterSessionServicerServer(grpcServer, server)
 
-	return grpcServer, activity, nil
+	return grpcServer, activity, server.close, nil
+}
+
+// close releases the worker pool and publisher, it is safe to call more than once.
+func (s *grpcSessionServer) close() {
+	s.closeOnce.Do(func() {
+		if s.wp != nil {
+			if err := s.wp.Close(); err != nil {
+				log.Error().Err(err).Msg("error closing worker pool")
+			}
+		}
+
+		if err := s.publisher.Close(); err != nil {
+			log.Error().Err(err).Msg("error closing publisher")
+		}
+	})
+}
+
+// broadcast publishes to passive listeners, failing to do so never stops an execution.
+func (s *grpcSessionServer) broadcast(msg *service_pb.Broadcast) {
+	if err := s.publisher.Publish(msg); err != nil {
+		log.Warn().Err(err).Msg("error broadcasting")
+	}
+}
+
+func (s *grpcSessionServer) broadcastEvent(name string, err error) {
+	event := &service_pb.Broadcast_Event{Name: name}
+	if err != nil {
+		msg := err.Error()
+		event.Message = &msg
+	}
+
+	s.broadcast(&service_pb.Broadcast{
+		Timestamp: timestamppb.Now(),
+		Payload:   &service_pb.Broadcast_Event_{Event: event},
+	})
 }
 
 func (s *grpcSessionServer) CreateExecution(ctx context.Context, req *backtest_pb.CreateExecutionRequest) (*backtest_pb.CreateExecutionResponse, error) {
@@ -77,30 +149,59 @@ func (s *grpcSessionServer) CreateExecution(ctx context.Context, req *backtest_p
 		return nil, fmt.Errorf("error creating execution: %w", err)
 	}
 
-	s.wp, err = worker.NewPool(ctx, req.GetAlgorithm())
+	options := []worker.PoolOption{
+		worker.WithPublisher(s.publisher),
+		worker.WithCredentials(s.credentials),
+		worker.WithPortOwner(ports.Owner{Kind: ports.Execution, ID: execution.Id}),
+		worker.WithNamespaceSnapshots(func(ctx context.Context, timestamp time.Time, snapshot map[string]*structpb.Struct) error {
+			return executions.StoreNamespaceSnapshot(ctx, execution.Id, timestamp, snapshot)
+		}),
+	}
+
+	if req.GetTransport() == service_pb.WorkerTransport_GRPC {
+		if s.broker == nil {
+			err = errors.New("grpc worker transport is not available")
+			if stErr := executions.UpdateStatus(ctx, execution.Id, backtest_pb.Execution_Status_FAILED, err); stErr != nil {
+				log.Error().Err(stErr).Str("execution_id", execution.Id).Msg("error updating status")
+			}
+
+			return nil, err
+		}
+
+		options = append(options, worker.WithBroker(s.broker))
+	}
+
+	// Session scoped namespaces are carried over from the previous execution
+	if s.wp != nil {
+		options = append(options, worker.WithNamespaceState(s.wp.Namespace().Snapshot(service_pb.NamespaceScope_SESSION)))
+
+		if err := s.wp.Close(); err != nil {
+			log.Error().Err(err).Msg("error closing previous worker pool")
+		}
+	}
+
+	wp, err := worker.NewPool(ctx, req.GetAlgorithm(), options...)
 	if err != nil {
 		log.Error().Err(err).Msg("error creating worker pool")
+
+		if stErr := executions.UpdateStatus(ctx, execution.Id, backtest_pb.Execution_Status_FAILED, err); stErr != nil {
+			log.Error().Err(stErr).Str("execution_id", execution.Id).Msg("error updating status")
+		}
+
 		return nil, fmt.Errorf("error creating worker pool: %w", err)
 	}
 
+	s.wp = wp
 	configuration := s.wp.Configure()
 
-	/*
-		exf := make([]*service_pb.ExecutionConfiguration_Function, 0)
-		for name, parameters := range functions {
-			fp := make([]*service_pb.ExecutionConfiguration_FunctionParameter, 0)
-			for k, v := range parameters.Parameters {
-				fp = append(fp, &service_pb.ExecutionConfiguration_FunctionParameter{
-					Key:   k,
-					Value: v,
-				})
-			}
-			exf = append(exf, &service_pb.ExecutionConfiguration_Function{
-				Name:       name,
-				Parameters: fp,
-			})
-		}
-	*/
+	err = executions.UpdateParameters(ctx, execution.Id, configuration.Functions)
+	if err != nil {
+		log.Error().Err(err).Msg("error storing execution parameters")
+		return nil, fmt.Errorf("error storing execution parameters: %w", err)
+	}
+
+	execution.Functions = configuration.Functions
+
 	log.Debug().Any("execution", execution).Any("configuration", configuration).Msg("execution created")
 
 	return &backtest_pb.CreateExecutionResponse{
@@ -143,7 +244,14 @@ func (s *grpcSessionServer) RunExecution(req *backtest_pb.RunExecutionRequest, s
 		return fmt.Errorf("error updating status: %w", err)
 	}
 
+	s.broadcastEvent("execution_started", nil)
+
 	for portfolio := range portfolioCh {
+		s.broadcast(&service_pb.Broadcast{
+			Timestamp: portfolio.Timestamp,
+			Payload:   &service_pb.Broadcast_Portfolio{Portfolio: portfolio},
+		})
+
 		err := stream.Send(&backtest_pb.RunExecutionResponse{
 			Portfolio: portfolio,
 		})
@@ -152,10 +260,27 @@ func (s *grpcSessionServer) RunExecution(req *backtest_pb.RunExecutionRequest, s
 			if stErr != nil {
 				log.Error().Err(stErr).Str("execution_id", req.ExecutionId).Msg("error updating status")
 			}
+			s.broadcastEvent("execution_failed", err)
+
 			return fmt.Errorf("error sending portfolio: %w", err)
 		}
 	}
 
+	if s.wp != nil && s.wp.Err() != nil {
+		err = s.wp.Err()
+
+		stErr := executions.UpdateStatus(context.Background(), req.ExecutionId, backtest_pb.Execution_Status_FAILED, err)
+		if stErr != nil {
+			log.Error().Err(stErr).Str("execution_id", req.ExecutionId).Msg("error updating status")
+		}
+
+		s.broadcastEvent("execution_failed", err)
+
+		return fmt.Errorf("error running execution: %w", err)
+	}
+
+	s.broadcastEvent("execution_completed", nil)
+
 	err = executions.UpdateStatus(context.Background(), req.ExecutionId, backtest_pb.Execution_Status_COMPLETED, nil)
 	if err != nil {
 		log.Error().Err(err).Str("execution_id", req.ExecutionId).Msg("error updating status")
@@ -201,11 +326,7 @@ func (s *grpcSessionServer) GetExecution(ctx context.Context, req *backtest_pb.G
 
 func (s *grpcSessionServer) StopServer(ctx context.Context, req *backtest_pb.StopServerRequest) (*backtest_pb.StopServerResponse, error) {
 	log.Debug().Any("request", req).Msg("stop server")
-	if s.wp != nil {
-		if err := s.wp.Close(); err != nil {
-			log.Error().Err(err).Msg("error closing worker pool")
-		}
-	}
+	s.close()
 	close(s.activity)
 	return &backtest_pb.StopServerResponse{}, nil
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/lhjnilsson/foreverbull/internal/ports"
 	"github.com/lhjnilsson/foreverbull/internal/test_helper"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/engine"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/backtest"
@@ -35,6 +36,7 @@ type SessionTest struct {
 	listener   *bufconn.Listener
 	baseServer *grpc.Server
 	activity   <-chan bool
+	release    func()
 
 	mockEngine        *engine.MockEngine
 	mockEngineSession *engine.MockEngineSession
@@ -78,7 +80,7 @@ func (s *SessionTest) SetupTest() {
 	s.mockEngineSession = new(engine.MockEngineSession)
 	s.mockEngine.On("NewSession", mock.Anything, mock.Anything).Return(s.mockEngineSession, nil)
 
-	s.baseServer, s.activity, err = backtest.NewGRPCSessionServer(s.session, s.conn, s.mockEngine)
+	s.baseServer, s.activity, s.release, err = backtest.NewGRPCSessionServer(s.session, s.conn, s.mockEngine, nil)
 	s.Require().NoError(err)
 
 	go func() {
@@ -109,6 +111,7 @@ func (s *SessionTest) TearDownTest() {
 	}
 
 	s.baseServer.Stop()
+	s.release()
 }
 
 func (s *SessionTest) TestCreateExecution() {
@@ -118,7 +121,16 @@ func (s *SessionTest) TestCreateExecution() {
 			EndDate:   &common_pb.Date{Year: 2024, Month: 0o1, Day: 0o1},
 			Symbols:   []string{"AAPL"},
 		},
-		Algorithm: &service_pb.Algorithm{},
+		Algorithm: &service_pb.Algorithm{
+			Functions: []*service_pb.Algorithm_Function{
+				{
+					Name: "test",
+					Parameters: []*service_pb.Algorithm_FunctionParameter{
+						{Key: "low", DefaultValue: func() *string { v := "5"; return &v }(), ValueType: "int"},
+					},
+				},
+			},
+		},
 	})
 	s.Require().NoError(err)
 	s.Require().NotNil(rsp)
@@ -127,6 +139,38 @@ func (s *SessionTest) TestCreateExecution() {
 	case <-time.After(5 * time.Second):
 		s.Require().Fail("timeout waiting for activity")
 	}
+
+	s.Require().Len(rsp.Configuration.Functions, 1)
+	s.Equal("5", rsp.Configuration.Functions[0].Parameters[0].Value)
+	s.NotNil(rsp.Configuration.Credentials, "worker sockets require tls")
+
+	executions := repository.Execution{Conn: s.conn}
+	execution, err := executions.Get(context.Background(), rsp.Execution.Id)
+	s.Require().NoError(err)
+	s.Require().Len(execution.Functions, 1)
+	s.Equal("low", execution.Functions[0].Parameters[0].Key)
+	s.Equal("5", execution.Functions[0].Parameters[0].Value)
+}
+
+func (s *SessionTest) TestCreateExecutionInvalidParameter() {
+	_, err := s.client.CreateExecution(context.Background(), &backtest_pb.CreateExecutionRequest{
+		Backtest: &backtest_pb.Backtest{
+			StartDate: &common_pb.Date{Year: 2024, Month: 0o1, Day: 0o1},
+			EndDate:   &common_pb.Date{Year: 2024, Month: 0o1, Day: 0o1},
+			Symbols:   []string{"AAPL"},
+		},
+		Algorithm: &service_pb.Algorithm{
+			Functions: []*service_pb.Algorithm_Function{
+				{
+					Name: "test",
+					Parameters: []*service_pb.Algorithm_FunctionParameter{
+						{Key: "low", Value: func() *string { v := "low"; return &v }(), ValueType: "int"},
+					},
+				},
+			},
+		},
+	})
+	s.ErrorContains(err, "low is not a valid int")
 }
 
 func (s *SessionTest) TestRunExecution() {
@@ -193,3 +237,23 @@ func (s *SessionTest) TestStopServer() {
 		s.Require().Fail("activity channel should be closed")
 	}
 }
+
+func (s *SessionTest) TestRelease() {
+	owned := func() bool {
+		for _, lease := range ports.Default().Leases() {
+			if lease.Owner == (ports.Owner{Kind: ports.Session, ID: s.session.Id}) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	s.Require().True(owned())
+	s.release()
+	s.False(owned())
+
+	// The session may still be stopped after it timed out
+	_, err := s.client.StopServer(context.Background(), &backtest_pb.StopServerRequest{})
+	s.Require().NoError(err)
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -12,6 +13,9 @@ import (
 	"github.com/lhjnilsson/foreverbull/internal/postgres"
 	internal_pb "github.com/lhjnilsson/foreverbull/pkg/pb"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/backtest"
+	service_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const ExecutionTable = `CREATE TABLE IF NOT EXISTS execution (
@@ -22,7 +26,10 @@ error TEXT,
 start_date date NOT NULL,
 end_date date,
 benchmark text,
-symbols text[]);
+symbols text[],
+parameters jsonb);
+
+ALTER TABLE execution ADD COLUMN IF NOT EXISTS parameters jsonb;
 
 CREATE TABLE IF NOT EXISTS execution_status (
 	id text REFERENCES execution(id) ON DELETE CASCADE,
@@ -53,6 +60,14 @@ EXCEPTION
 		NULL;
 END$$;
 
+CREATE TABLE IF NOT EXISTS execution_namespace (
+	execution text REFERENCES execution(id) ON DELETE CASCADE,
+	date date NOT NULL,
+	namespace text NOT NULL,
+	value jsonb NOT NULL,
+	PRIMARY KEY (execution, date, namespace)
+);
+
 CREATE TABLE IF NOT EXISTS backtest_period (
 	id serial primary key,
 	backtest_execution text not null,
@@ -175,7 +190,7 @@ func (db *Execution) Get(ctx context.Context, executionId string) (*pb.Execution
 	execution := pb.Execution{}
 
 	rows, err := db.Conn.Query(ctx,
-		`SELECT execution.id, session, start_date, end_date, benchmark, symbols,
+		`SELECT execution.id, session, start_date, end_date, benchmark, symbols, parameters,
 		es.status, es.error, es.occurred_at
 		FROM execution
 		INNER JOIN (
@@ -193,13 +208,19 @@ func (db *Execution) Get(ctx context.Context, executionId string) (*pb.Execution
 		start := time.Time{}
 		end := pgtype.Date{}
 		occurred_at := time.Time{}
+		parameters := []byte{}
 
 		err = rows.Scan(&execution.Id, &execution.Session, &start, &end, &execution.Benchmark,
-			&execution.Symbols, &status.Status, &status.Error, &occurred_at)
+			&execution.Symbols, &parameters, &status.Status, &status.Error, &occurred_at)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan execution: %w", err)
 		}
 
+		execution.Functions, err = parametersFromJSON(parameters)
+		if err != nil {
+			return nil, err
+		}
+
 		execution.StartDate = internal_pb.GoTimeToDate(start)
 		if end.Valid {
 			execution.EndDate = internal_pb.GoTimeToDate(end.Time)
@@ -383,6 +404,105 @@ func (db *Execution) UpdateSimulationDetails(ctx context.Context, e *pb.Executio
 	return nil
 }
 
+// StoreNamespaceSnapshot stores the namespace state of the execution at the end of date,
+// a snapshot that already exists for the date is replaced.
+func (db *Execution) StoreNamespaceSnapshot(ctx context.Context, execution string, date time.Time,
+	snapshot map[string]*structpb.Struct,
+) error {
+	for namespace, value := range snapshot {
+		data, err := protojson.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal namespace: %w", err)
+		}
+
+		_, err = db.Conn.Exec(ctx,
+			`INSERT INTO execution_namespace (execution, date, namespace, value) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (execution, date, namespace) DO UPDATE SET value=EXCLUDED.value`,
+			execution, date.Format(time.DateOnly), namespace, data)
+		if err != nil {
+			return fmt.Errorf("failed to store namespace snapshot: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetNamespaceSnapshot returns the latest namespace snapshot at or before date, or the
+// last snapshot when date is nil.
+func (db *Execution) GetNamespaceSnapshot(ctx context.Context, execution string, date *time.Time,
+) (*time.Time, map[string]*structpb.Struct, error) {
+	var snapshotDate *time.Time
+
+	query := `SELECT max(date) FROM execution_namespace WHERE execution=$1`
+	args := []interface{}{execution}
+
+	if date != nil {
+		query += ` AND date<=$2`
+
+		args = append(args, date.Format(time.DateOnly))
+	}
+
+	err := db.Conn.QueryRow(ctx, query, args...).Scan(&snapshotDate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get namespace snapshot: %w", err)
+	}
+
+	if snapshotDate == nil {
+		return nil, nil, errors.New("namespace snapshot not found")
+	}
+
+	rows, err := db.Conn.Query(ctx,
+		`SELECT namespace, value FROM execution_namespace WHERE execution=$1 AND date=$2`,
+		execution, snapshotDate.Format(time.DateOnly))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get namespace snapshot: %w", err)
+	}
+
+	defer rows.Close()
+
+	snapshot := make(map[string]*structpb.Struct)
+
+	for rows.Next() {
+		var namespace string
+
+		var data []byte
+
+		if err := rows.Scan(&namespace, &data); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan namespace snapshot: %w", err)
+		}
+
+		value := &structpb.Struct{}
+		if err := protojson.Unmarshal(data, value); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal namespace: %w", err)
+		}
+
+		snapshot[namespace] = value
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read namespace snapshot: %w", err)
+	}
+
+	return snapshotDate, snapshot, nil
+}
+
+// UpdateParameters stores the parameters the execution is configured with.
+func (db *Execution) UpdateParameters(ctx context.Context, executionId string,
+	functions []*service_pb.ExecutionConfiguration_Function,
+) error {
+	parameters, err := parametersToJSON(functions)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Conn.Exec(ctx, "UPDATE execution SET parameters=$2 WHERE id=$1", executionId, parameters)
+	if err != nil {
+		return fmt.Errorf("failed to update execution parameters: %w", err)
+	}
+
+	return nil
+}
+
 func (db *Execution) UpdateStatus(ctx context.Context, executionId string, status pb.Execution_Status_Status, err error) error {
 	if err != nil {
 		_, err = db.Conn.Exec(ctx, "UPDATE execution SET status=$2, error=$3 WHERE id=$1", executionId, status, err.Error())
@@ -412,6 +532,7 @@ func (db *Execution) parseRows(rows pgx.Rows) ([]*pb.Execution, error) {
 		start := time.Time{}
 		end := pgtype.Date{}
 		occurredAt := time.Time{}
+		parameters := []byte{}
 
 		pnl := sql.NullFloat64{}
 		returns := sql.NullFloat64{}
@@ -445,7 +566,7 @@ func (db *Execution) parseRows(rows pgx.Rows) ([]*pb.Execution, error) {
 		beta := sql.NullFloat64{}
 
 		err = rows.Scan(&execution.Id, &execution.Session, &execution.Backtest, &start, &end, &execution.Benchmark,
-			&execution.Symbols, &status.Status, &status.Error, &occurredAt,
+			&execution.Symbols, &parameters, &status.Status, &status.Error, &occurredAt,
 			&resultDate, &pnl, &returns, &portfolioValue, &longsCount, &shortsCount,
 			&longValue, &shortValue, &startingExposure, &endingExposure, &longExposure,
 			&shortExposure, &capitalUsed, &grossLeverage, &netLeverage, &startingValue,
@@ -461,6 +582,11 @@ func (db *Execution) parseRows(rows pgx.Rows) ([]*pb.Execution, error) {
 			execution.EndDate = internal_pb.GoTimeToDate(end.Time)
 		}
 
+		execution.Functions, err = parametersFromJSON(parameters)
+		if err != nil {
+			return nil, err
+		}
+
 		status.OccurredAt = internal_pb.TimeToProtoTimestamp(occurredAt)
 
 		inReturnSlice = false
@@ -578,7 +704,7 @@ func (db *Execution) parseRows(rows pgx.Rows) ([]*pb.Execution, error) {
 func (db *Execution) List(ctx context.Context) ([]*pb.Execution, error) {
 	rows, err := db.Conn.Query(ctx,
 		`SELECT execution.id, session.id, session.backtest, execution.start_date, execution.end_date, benchmark, symbols,
-		es.status, es.error, es.occurred_at,
+		execution.parameters, es.status, es.error, es.occurred_at,
 		ep.date, ep.pnl, ep.returns, ep.portfolio_value, ep.longs_count, ep.shorts_count,
 		ep.long_value, ep.short_value, ep.starting_exposure, ep.ending_exposure, ep.long_exposure, ep.short_exposure,
 		ep.capital_used, ep.gross_leverage, ep.net_leverage,
@@ -612,7 +738,7 @@ func (db *Execution) List(ctx context.Context) ([]*pb.Execution, error) {
 func (db *Execution) ListBySession(ctx context.Context, session string) ([]*pb.Execution, error) {
 	rows, err := db.Conn.Query(ctx,
 		`SELECT execution.id, session.id, session.backtest, execution.start_date, execution.end_date, benchmark, symbols,
-		es.status, es.error, es.occurred_at,
+		execution.parameters, es.status, es.error, es.occurred_at,
 		ep.date, ep.pnl, ep.returns, ep.portfolio_value, ep.longs_count, ep.shorts_count,
 		ep.long_value, ep.short_value, ep.starting_exposure, ep.ending_exposure, ep.long_exposure, ep.short_exposure,
 		ep.capital_used, ep.gross_leverage, ep.net_leverage,
@@ -647,7 +773,7 @@ func (db *Execution) ListBySession(ctx context.Context, session string) ([]*pb.E
 func (db *Execution) ListByBacktest(ctx context.Context, backtest string) ([]*pb.Execution, error) {
 	rows, err := db.Conn.Query(ctx,
 		`SELECT execution.id, session.id, session.backtest, execution.start_date, execution.end_date,
-		execution.benchmark, execution.symbols,
+		execution.benchmark, execution.symbols, execution.parameters,
 		es.status, es.error, es.occurred_at,
 		ep.date, ep.pnl, ep.returns, ep.portfolio_value, ep.longs_count, ep.shorts_count,
 		ep.long_value, ep.short_value, ep.starting_exposure, ep.ending_exposure, ep.long_exposure, ep.short_exposure,
@@ -680,3 +806,47 @@ func (db *Execution) ListByBacktest(ctx context.Context, backtest string) ([]*pb
 
 	return db.parseRows(rows)
 }
+
+func parametersToJSON(functions []*service_pb.ExecutionConfiguration_Function) ([]byte, error) {
+	entries := make([]json.RawMessage, 0, len(functions))
+
+	for _, function := range functions {
+		entry, err := protojson.Marshal(function)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal parameters: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	parameters, err := json.Marshal(entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal parameters: %w", err)
+	}
+
+	return parameters, nil
+}
+
+func parametersFromJSON(parameters []byte) ([]*service_pb.ExecutionConfiguration_Function, error) {
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+
+	entries := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(parameters, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal parameters: %w", err)
+	}
+
+	functions := make([]*service_pb.ExecutionConfiguration_Function, 0, len(entries))
+
+	for _, entry := range entries {
+		function := &service_pb.ExecutionConfiguration_Function{}
+		if err := protojson.Unmarshal(entry, function); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal parameters: %w", err)
+		}
+
+		functions = append(functions, function)
+	}
+
+	return functions, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lhjnilsson/foreverbull/internal/environment"
@@ -11,7 +12,9 @@ import (
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/repository"
 	common_pb "github.com/lhjnilsson/foreverbull/pkg/pb"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/backtest"
+	service_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type ExecutionTest struct {
@@ -98,6 +101,75 @@ func (test *ExecutionTest) TestGet() {
 	test.NotNil(execution.Statuses[0].OccurredAt)
 }
 
+func (test *ExecutionTest) TestUpdateParameters() {
+	executions := repository.Execution{Conn: test.conn}
+	ctx := context.Background()
+	execution, err := executions.Create(ctx, test.storedSession.Id,
+		test.storedBacktest.StartDate, test.storedBacktest.EndDate, test.storedBacktest.Symbols, test.storedBacktest.Benchmark)
+	test.Require().NoError(err)
+	test.Empty(execution.Functions)
+
+	functions := []*service_pb.ExecutionConfiguration_Function{
+		{Name: "rank", Parameters: []*service_pb.ExecutionConfiguration_FunctionParameter{{Key: "low", Value: "5"}}},
+		{Name: "allocate"},
+	}
+	test.Require().NoError(executions.UpdateParameters(ctx, execution.Id, functions))
+
+	execution, err = executions.Get(ctx, execution.Id)
+	test.Require().NoError(err)
+	test.Require().Len(execution.Functions, 2)
+	test.Equal("rank", execution.Functions[0].Name)
+	test.Equal("low", execution.Functions[0].Parameters[0].Key)
+	test.Equal("5", execution.Functions[0].Parameters[0].Value)
+	test.Equal("allocate", execution.Functions[1].Name)
+
+	listed, err := executions.ListBySession(ctx, test.storedSession.Id)
+	test.Require().NoError(err)
+	test.Require().Len(listed, 1)
+	test.Len(listed[0].Functions, 2)
+}
+
+func (test *ExecutionTest) TestNamespaceSnapshot() {
+	executions := repository.Execution{Conn: test.conn}
+	ctx := context.Background()
+	execution, err := executions.Create(ctx, test.storedSession.Id,
+		test.storedBacktest.StartDate, test.storedBacktest.EndDate, test.storedBacktest.Symbols, test.storedBacktest.Benchmark)
+	test.Require().NoError(err)
+
+	_, _, err = executions.GetNamespaceSnapshot(ctx, execution.Id, nil)
+	test.Require().Error(err)
+
+	day1 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	for i, day := range []time.Time{day1, day2} {
+		counter, err := structpb.NewStruct(map[string]interface{}{"value": i + 1})
+		test.Require().NoError(err)
+		test.Require().NoError(executions.StoreNamespaceSnapshot(ctx, execution.Id, day,
+			map[string]*structpb.Struct{"counter": counter}))
+	}
+
+	// Storing the same period again replaces the snapshot
+	counter, err := structpb.NewStruct(map[string]interface{}{"value": 5})
+	test.Require().NoError(err)
+	test.Require().NoError(executions.StoreNamespaceSnapshot(ctx, execution.Id, day2,
+		map[string]*structpb.Struct{"counter": counter}))
+
+	date, snapshot, err := executions.GetNamespaceSnapshot(ctx, execution.Id, nil)
+	test.Require().NoError(err)
+	test.Equal(day2, date.UTC())
+	test.InDelta(5, snapshot["counter"].Fields["value"].GetNumberValue(), 0)
+
+	date, snapshot, err = executions.GetNamespaceSnapshot(ctx, execution.Id, &day1)
+	test.Require().NoError(err)
+	test.Equal(day1, date.UTC())
+	test.InDelta(1, snapshot["counter"].Fields["value"].GetNumberValue(), 0)
+
+	before := day1.AddDate(0, 0, -1)
+	_, _, err = executions.GetNamespaceSnapshot(ctx, execution.Id, &before)
+	test.Require().Error(err)
+}
+
 func (test *ExecutionTest) TestGetPeriods() {
 	executions := repository.Execution{Conn: test.conn}
 	ctx := context.Background()
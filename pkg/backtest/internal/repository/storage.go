@@ -28,6 +28,10 @@ func Recreate(ctx context.Context, conn *pgxpool.Pool) error { //nolint: cyclop
 		return fmt.Errorf("failed to drop table execution_status: %w", err)
 	}
 
+	if _, err := conn.Exec(ctx, `DROP TABLE IF EXISTS execution_namespace;`); err != nil {
+		return fmt.Errorf("failed to drop table execution_namespace: %w", err)
+	}
+
 	if _, err := conn.Exec(ctx, `DROP TABLE IF EXISTS execution;`); err != nil {
 		return fmt.Errorf("failed to drop table execution: %w", err)
 	}
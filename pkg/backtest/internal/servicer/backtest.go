@@ -4,12 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/ports"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/repository"
 	msg "github.com/lhjnilsson/foreverbull/pkg/backtest/stream"
+	common_pb "github.com/lhjnilsson/foreverbull/pkg/pb"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/backtest"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type BacktestServer struct {
@@ -81,7 +86,14 @@ func (bs *BacktestServer) GetBacktest(ctx context.Context,
 func (bs *BacktestServer) CreateSession(ctx context.Context,
 	req *pb.CreateSessionRequest,
 ) (*pb.CreateSessionResponse, error) {
-	sessions := repository.Session{Conn: bs.pgx}
+	tx, err := bs.pgx.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx) //nolint: errcheck
+
+	sessions := repository.Session{Conn: tx}
 
 	session, err := sessions.Create(ctx, req.GetBacktestName())
 	if err != nil {
@@ -93,11 +105,20 @@ func (bs *BacktestServer) CreateSession(ctx context.Context,
 		return nil, fmt.Errorf("error creating session run command: %w", err)
 	}
 
-	err = bs.stream.Publish(ctx, msg)
+	err = bs.stream.PublishTx(ctx, tx, msg)
 	if err != nil {
 		return nil, fmt.Errorf("error publishing session run command: %w", err)
 	}
 
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	// The command is stored, if publishing it fails the relay publishes it later
+	if err = bs.stream.Publish(ctx, msg); err != nil {
+		log.Warn().Err(err).Str("session", session.Id).Msg("error publishing session run command")
+	}
+
 	return &pb.CreateSessionResponse{
 		Session: session,
 	}, nil
@@ -172,3 +193,54 @@ func (bs *BacktestServer) GetExecution(ctx context.Context,
 		Periods:   periods,
 	}, nil
 }
+
+func (bs *BacktestServer) GetExecutionNamespace(ctx context.Context,
+	req *pb.GetExecutionNamespaceRequest,
+) (*pb.GetExecutionNamespaceResponse, error) {
+	storage := repository.Execution{Conn: bs.pgx}
+
+	var date *time.Time
+
+	if req.Date != nil {
+		d := common_pb.DateToTime(req.GetDate())
+		date = &d
+	}
+
+	snapshotDate, namespaces, err := storage.GetNamespaceSnapshot(ctx, req.GetExecutionId(), date)
+	if err != nil {
+		return nil, fmt.Errorf("error getting execution namespace: %w", err)
+	}
+
+	return &pb.GetExecutionNamespaceResponse{
+		Date:       common_pb.GoTimeToDate(*snapshotDate),
+		Namespaces: namespaces,
+	}, nil
+}
+
+func (bs *BacktestServer) ListPortLeases(_ context.Context,
+	_ *pb.ListPortLeasesRequest,
+) (*pb.ListPortLeasesResponse, error) {
+	allocator := ports.Default()
+	start, end := allocator.Range()
+
+	response := &pb.ListPortLeasesResponse{
+		RangeStart: int32(start),
+		RangeEnd:   int32(end),
+	}
+
+	for _, lease := range allocator.Leases() {
+		l := &pb.ListPortLeasesResponse_Lease{
+			Port:      int32(lease.Port),
+			OwnerKind: string(lease.Owner.Kind),
+			OwnerId:   lease.Owner.ID,
+			Acquired:  timestamppb.New(lease.Acquired),
+		}
+		if !lease.Expires.IsZero() {
+			l.Expires = timestamppb.New(lease.Expires)
+		}
+
+		response.Leases = append(response.Leases, l)
+	}
+
+	return response, nil
+}
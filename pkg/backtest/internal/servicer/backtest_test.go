@@ -164,6 +164,7 @@ func (suite *BacktestServerTest) TestGetBacktest() {
 func (suite *BacktestServerTest) TestCreateSession() {
 	backtest := suite.createBacktest("test_1")
 
+	suite.stream.On("PublishTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	suite.stream.On("Publish", mock.Anything, mock.Anything).Return(nil)
 
 	req := &pb.CreateSessionRequest{
@@ -173,6 +174,7 @@ func (suite *BacktestServerTest) TestCreateSession() {
 	resp, err := suite.client.CreateSession(context.Background(), req)
 	suite.Require().NoError(err)
 	suite.NotNil(resp)
+	suite.stream.AssertCalled(suite.T(), "Publish", mock.Anything, mock.Anything)
 }
 
 func (suite *BacktestServerTest) TestGetSession() {
@@ -2,6 +2,7 @@ package servicer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -94,6 +95,17 @@ func (is *IngestionServer) UpdateIngestion(req *pb.UpdateIngestionRequest,
 
 	for {
 		ingestion, err := is.storage.GetObject(stream.Context(), storage.IngestionsBucket, name)
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			// The ingestion is removed when the orchestration is rolled back
+			err = stream.Send(&pb.UpdateIngestionResponse{
+				Ingestion: &pb.Ingestion{},
+				Status:    pb.IngestionStatus_ERROR,
+			})
+			if err != nil {
+				return fmt.Errorf("Error sending: %w", err)
+			}
+			break
+		}
 		if err != nil {
 			return fmt.Errorf("Error getting ingestion: %w", err)
 		}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/lhjnilsson/foreverbull/internal/storage"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
-	"github.com/lhjnilsson/foreverbull/pkg/backtest/engine"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/stream/dependency"
 	ss "github.com/lhjnilsson/foreverbull/pkg/backtest/stream"
 	pb_internal "github.com/lhjnilsson/foreverbull/pkg/pb"
@@ -16,17 +15,10 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-func Ingest(ctx context.Context, msg stream.Message) error {
-	command := ss.IngestCommand{}
-
-	err := msg.ParsePayload(&command)
+func Ingest(ctx context.Context, msg stream.Message, command ss.IngestCommand) error {
+	store, err := stream.Storage.Get(msg)
 	if err != nil {
-		return fmt.Errorf("error unmarshalling Ingest payload: %w", err)
-	}
-
-	store, isStorage := msg.MustGet(stream.StorageDep).(storage.Storage)
-	if !isStorage {
-		return errors.New("error casting storage")
+		return fmt.Errorf("error getting storage: %w", err)
 	}
 
 	object, err := store.GetObject(ctx, storage.IngestionsBucket, command.Name)
@@ -44,16 +36,11 @@ func Ingest(ctx context.Context, msg stream.Message) error {
 
 	setMetadata(ctx, pb.IngestionStatus_INGESTING)
 
-	ze, err := msg.Call(ctx, dependency.GetEngineKey)
+	engine, err := dependency.Engine.Call(ctx, msg)
 	if err != nil {
 		return fmt.Errorf("error getting zipline engine: %w", err)
 	}
 
-	engine, isEngine := ze.(engine.Engine)
-	if !isEngine {
-		return errors.New("error casting zipline engine")
-	}
-
 	ingestion := pb.Ingestion{
 		StartDate: pb_internal.DateStringToDate(command.Start),
 		EndDate:   pb_internal.DateStringToDate(command.End),
@@ -85,20 +72,17 @@ func Ingest(ctx context.Context, msg stream.Message) error {
 	return nil
 }
 
-func UpdateIngestionStatus(ctx context.Context, msg stream.Message) error {
-	command := ss.UpdateStatusCommand{}
-
-	err := msg.ParsePayload(&command)
+func UpdateIngestionStatus(ctx context.Context, msg stream.Message, command ss.UpdateStatusCommand) error {
+	store, err := stream.Storage.Get(msg)
 	if err != nil {
-		return fmt.Errorf("error unmarshalling Ingest payload: %w", err)
-	}
-
-	store, isStorage := msg.MustGet(stream.StorageDep).(storage.Storage)
-	if !isStorage {
-		return errors.New("error casting storage")
+		return fmt.Errorf("error getting storage: %w", err)
 	}
 
 	object, err := store.GetObject(ctx, storage.IngestionsBucket, command.Name)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		log.Info().Str("ingestion", command.Name).Msg("ingestion has been removed, status not updated")
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("error getting object from storage: %w", err)
 	}
@@ -110,3 +94,17 @@ func UpdateIngestionStatus(ctx context.Context, msg stream.Message) error {
 	}
 	return nil
 }
+
+// RemoveIngestion removes a partially downloaded ingestion when its orchestration is rolled back.
+func RemoveIngestion(ctx context.Context, msg stream.Message, command ss.RemoveIngestionCommand) error {
+	store, err := stream.Storage.Get(msg)
+	if err != nil {
+		return fmt.Errorf("error getting storage: %w", err)
+	}
+
+	err = store.RemoveObject(ctx, storage.IngestionsBucket, command.Name)
+	if err != nil {
+		return fmt.Errorf("error removing object from storage: %w", err)
+	}
+	return nil
+}
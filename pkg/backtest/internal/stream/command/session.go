@@ -5,14 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"syscall"
 	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/lhjnilsson/foreverbull/internal/ports"
 	"github.com/lhjnilsson/foreverbull/internal/storage"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
-	"github.com/lhjnilsson/foreverbull/pkg/backtest/engine"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/backtest"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/repository"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/stream/dependency"
@@ -25,16 +22,17 @@ const (
 	SessionTimeout = 30 * time.Minute
 )
 
-func SessionRun(ctx context.Context, msg stream.Message) error {
-	command := ss.SessionRunCommand{}
+func SessionRun(ctx context.Context, msg stream.Message, command ss.SessionRunCommand) error {
+	db, err := stream.DB.Get(msg)
+	if err != nil {
+		return fmt.Errorf("error getting db: %w", err)
+	}
 
-	err := msg.ParsePayload(&command)
+	s, err := stream.Storage.Get(msg)
 	if err != nil {
-		return fmt.Errorf("error unmarshalling SessionRun payload: %w", err)
+		return fmt.Errorf("error getting storage: %w", err)
 	}
 
-	db := msg.MustGet(stream.DBDep).(*pgxpool.Pool)
-	s := msg.MustGet(stream.StorageDep).(storage.Storage)
 	sessions := repository.Session{Conn: db}
 
 	session, err := sessions.Get(ctx, command.SessionID)
@@ -43,7 +41,7 @@ func SessionRun(ctx context.Context, msg stream.Message) error {
 		return fmt.Errorf("error getting session: %w", err)
 	}
 
-	depEngine, err := msg.Call(ctx, dependency.GetEngineKey)
+	engine, err := dependency.Engine.Call(ctx, msg)
 	if err != nil {
 		log.Err(err).Msg("error getting zipline engine")
 
@@ -54,8 +52,6 @@ func SessionRun(ctx context.Context, msg stream.Message) error {
 		return fmt.Errorf("error getting zipline engine: %w", err)
 	}
 
-	engine := depEngine.(engine.Engine)
-
 	var ingestions *[]storage.Object
 
 	ingestions, err = s.ListObjects(ctx, storage.IngestionsBucket)
@@ -111,7 +107,12 @@ func SessionRun(ctx context.Context, msg stream.Message) error {
 		return fmt.Errorf("error downloading ingestion: %w", err)
 	}
 
-	server, activity, err := backtest.NewGRPCSessionServer(session, db, engine)
+	broker, err := dependency.WorkerBroker.Get(msg)
+	if err != nil {
+		return fmt.Errorf("error getting worker broker: %w", err)
+	}
+
+	server, activity, release, err := backtest.NewGRPCSessionServer(session, db, engine, broker)
 	if err != nil {
 		log.Err(err).Msg("error creating grpc session server")
 
@@ -124,26 +125,31 @@ func SessionRun(ctx context.Context, msg stream.Message) error {
 
 	var listener net.Listener
 
-	var port int
-
-	portRangeEnd := environment.GetBacktestPortRangeEnd()
+	// The lease expires with the session, it is renewed on every activity
+	lease, err := ports.Default().Acquire(ports.Owner{Kind: ports.Session, ID: command.SessionID}, SessionTimeout,
+		func(port int) error {
+			var err error
+			listener, err = net.Listen("tcp", fmt.Sprintf(":%d", port))
 
-	for port = environment.GetBacktestPortRangeStart(); port < portRangeEnd; port++ {
-		listener, err = net.Listen("tcp", fmt.Sprintf(":%d", port))
-		if err == nil {
-			break
-		}
+			return err
+		})
+	if err != nil {
+		release()
 
-		opErr := &net.OpError{}
-		if errors.As(err, &opErr) && errors.Is(opErr, syscall.EADDRINUSE) {
-			continue
+		if inErr := sessions.UpdateStatus(ctx, command.SessionID, pb.Session_Status_FAILED, err); inErr != nil {
+			log.Err(inErr).Msg("error updating session status")
 		}
 
 		return fmt.Errorf("error creating listener: %w", err)
 	}
-	if listener == nil {
-		return fmt.Errorf("Listener is nil")
-	}
+
+	// A session that stopped renewing its lease is closed before the port is reused
+	lease.OnExpire(func() {
+		server.Stop()
+		release()
+	})
+
+	port := lease.Port
 
 	go func() {
 		err := server.Serve(listener)
@@ -155,6 +161,8 @@ func SessionRun(ctx context.Context, msg stream.Message) error {
 		defer func() {
 			log.Info().Msg("closing session server")
 			server.Stop()
+			release()
+			lease.Release()
 		}()
 
 		if inErr := sessions.UpdateStatus(ctx, command.SessionID, pb.Session_Status_RUNNING, nil); inErr != nil {
@@ -174,6 +182,8 @@ func SessionRun(ctx context.Context, msg stream.Message) error {
 					time.Sleep(time.Second / 4) // make sure reply is sent
 					return
 				}
+
+				lease.Renew(SessionTimeout)
 			case <-time.After(SessionTimeout):
 				return
 			}
@@ -186,3 +196,42 @@ func SessionRun(ctx context.Context, msg stream.Message) error {
 
 	return nil
 }
+
+// SessionRunFinished marks the session as failed when its run command errored or was
+// canceled before the session could report a final status itself.
+func SessionRunFinished(ctx context.Context, msg stream.Message) error {
+	if msg.GetStatus() != stream.MessageStatusError && msg.GetStatus() != stream.MessageStatusCanceled {
+		return nil
+	}
+
+	command, err := ss.SessionRun.Parse(msg)
+	if err != nil {
+		return stream.NonRetryable(fmt.Errorf("error parsing session run command: %w", err))
+	}
+
+	db, err := stream.DB.Get(msg)
+	if err != nil {
+		return fmt.Errorf("error getting db: %w", err)
+	}
+
+	sessions := repository.Session{Conn: db}
+
+	session, err := sessions.Get(ctx, command.SessionID)
+	if err != nil {
+		return fmt.Errorf("error getting session: %w", err)
+	}
+
+	if len(session.Statuses) > 0 {
+		switch session.Statuses[0].Status {
+		case pb.Session_Status_COMPLETED, pb.Session_Status_FAILED:
+			return nil
+		}
+	}
+
+	err = sessions.UpdateStatus(ctx, command.SessionID, pb.Session_Status_FAILED, errors.New(msg.GetError()))
+	if err != nil {
+		return fmt.Errorf("error updating session status: %w", err)
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	ss "github.com/lhjnilsson/foreverbull/pkg/backtest/stream"
 	common_pb "github.com/lhjnilsson/foreverbull/pkg/pb"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/backtest"
+	"github.com/lhjnilsson/foreverbull/pkg/service/worker"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
@@ -65,41 +66,30 @@ func (test *CommandSessionTest) TearDownSubTest() {
 }
 
 func (test *CommandSessionTest) TestSessionRun() {
-	test.Run("Fail to unmarshal", func() {
-		message := new(stream.MockMessage)
-		parse := message.On("ParsePayload", &ss.SessionRunCommand{}).Return(errors.New("not working"))
-		err := command.SessionRun(context.TODO(), message)
-		test.Require().Error(err)
-		test.Require().Len(parse.Parent.Calls, 1)
-	})
 	test.Run("Session not stored", func() {
 		message := new(stream.MockMessage)
 		message.On("MustGet", stream.DBDep).Return(test.db)
 		message.On("MustGet", stream.StorageDep).Return(test.storage)
-		message.On("ParsePayload", &ss.SessionRunCommand{}).Return(nil).Run(func(args mock.Arguments) {
-			payload := args.Get(0).(*ss.SessionRunCommand)
-			payload.Backtest = test.backtest.Name
-			payload.SessionID = "not stored"
-		})
+		payload := ss.SessionRunCommand{
+			Backtest:  test.backtest.Name,
+			SessionID: "not stored",
+		}
 
-		err := command.SessionRun(context.TODO(), message)
+		err := command.SessionRun(context.TODO(), message, payload)
 		test.Require().Error(err)
-		message.AssertCalled(test.T(), "ParsePayload", mock.Anything)
 		message.AssertCalled(test.T(), "MustGet", stream.DBDep)
 	})
 	test.Run("Fail to get engine", func() {
 		message := new(stream.MockMessage)
 		message.On("MustGet", stream.DBDep).Return(test.db)
 		message.On("MustGet", stream.StorageDep).Return(test.storage)
-		message.On("ParsePayload", &ss.SessionRunCommand{}).Return(nil).Run(func(args mock.Arguments) {
-			payload := args.Get(0).(*ss.SessionRunCommand)
-			payload.Backtest = test.backtest.Name
-			payload.SessionID = test.session.Id
-		})
+		payload := ss.SessionRunCommand{
+			Backtest:  test.backtest.Name,
+			SessionID: test.session.Id,
+		}
 		message.On("Call", mock.Anything, dependency.GetEngineKey).Return(nil, errors.New("not working"))
-		err := command.SessionRun(context.TODO(), message)
+		err := command.SessionRun(context.TODO(), message, payload)
 		test.Require().Error(err)
-		message.AssertCalled(test.T(), "ParsePayload", mock.Anything)
 		message.AssertCalled(test.T(), "MustGet", stream.DBDep)
 		message.AssertCalled(test.T(), "Call", mock.Anything, dependency.GetEngineKey)
 	})
@@ -112,13 +102,12 @@ func (test *CommandSessionTest) TestSessionRun() {
 
 		ingestions := []storage.Object{}
 		test.storage.On("ListObjects", mock.Anything, storage.IngestionsBucket).Return(&ingestions, nil)
-		message.On("ParsePayload", &ss.SessionRunCommand{}).Return(nil).Run(func(args mock.Arguments) {
-			payload := args.Get(0).(*ss.SessionRunCommand)
-			payload.Backtest = test.backtest.Name
-			payload.SessionID = test.session.Id
-		})
+		payload := ss.SessionRunCommand{
+			Backtest:  test.backtest.Name,
+			SessionID: test.session.Id,
+		}
 		message.On("Call", mock.Anything, dependency.GetEngineKey).Return(engine, nil)
-		err := command.SessionRun(context.TODO(), message)
+		err := command.SessionRun(context.TODO(), message, payload)
 		test.Require().ErrorContains(err, "no ingestions found")
 	})
 	test.Run("successful", func() {
@@ -138,15 +127,14 @@ func (test *CommandSessionTest) TestSessionRun() {
 			},
 		}
 		test.storage.On("ListObjects", mock.Anything, storage.IngestionsBucket).Return(&ingestions, nil)
-		message.On("ParsePayload", &ss.SessionRunCommand{}).Return(nil).Run(func(args mock.Arguments) {
-			payload := args.Get(0).(*ss.SessionRunCommand)
-			payload.Backtest = test.backtest.Name
-			payload.SessionID = test.session.Id
-		})
+		payload := ss.SessionRunCommand{
+			Backtest:  test.backtest.Name,
+			SessionID: test.session.Id,
+		}
 		message.On("Call", mock.Anything, dependency.GetEngineKey).Return(engine, nil)
-		err := command.SessionRun(context.TODO(), message)
+		message.On("MustGet", dependency.WorkerBrokerDep).Return(worker.NewBroker())
+		err := command.SessionRun(context.TODO(), message, payload)
 		test.Require().NoError(err)
-		message.AssertCalled(test.T(), "ParsePayload", mock.Anything)
 		message.AssertCalled(test.T(), "MustGet", stream.DBDep)
 		message.AssertCalled(test.T(), "Call", mock.Anything, dependency.GetEngineKey)
 		time.Sleep(time.Second / 2) // Wait for the session to start
@@ -176,3 +164,58 @@ func (test *CommandSessionTest) TestSessionRun() {
 		test.Equal(pb.Session_Status_COMPLETED, session.Statuses[0].Status)
 	})
 }
+
+func (test *CommandSessionTest) TestSessionRunFinished() {
+	newMessage := func(status stream.MessageStatus) *stream.MockMessage {
+		run, err := ss.NewSessionRunCommand(test.backtest.Name, test.session.Id)
+		test.Require().NoError(err)
+
+		message := new(stream.MockMessage)
+		message.On("GetStatus").Return(status)
+		message.On("GetError").Return("session crashed")
+		message.On("RawPayload").Return(run.RawPayload())
+		message.On("GetSchemaVersion").Return(run.GetSchemaVersion())
+		message.On("MustGet", stream.DBDep).Return(test.db)
+
+		return message
+	}
+
+	test.Run("failed", func() {
+		message := newMessage(stream.MessageStatusError)
+
+		err := command.SessionRunFinished(context.TODO(), message)
+		test.Require().NoError(err)
+
+		sessions := repository.Session{Conn: test.db}
+		session, err := sessions.Get(context.TODO(), test.session.Id)
+		test.Require().NoError(err)
+		test.Equal(pb.Session_Status_FAILED, session.Statuses[0].Status)
+		test.Equal("session crashed", session.Statuses[0].GetError())
+	})
+	test.Run("completed", func() {
+		message := newMessage(stream.MessageStatusComplete)
+
+		err := command.SessionRunFinished(context.TODO(), message)
+		test.Require().NoError(err)
+		message.AssertNotCalled(test.T(), "MustGet", stream.DBDep)
+
+		sessions := repository.Session{Conn: test.db}
+		session, err := sessions.Get(context.TODO(), test.session.Id)
+		test.Require().NoError(err)
+		test.Equal(pb.Session_Status_CREATED, session.Statuses[0].Status)
+	})
+	test.Run("already completed", func() {
+		sessions := repository.Session{Conn: test.db}
+		err := sessions.UpdateStatus(context.TODO(), test.session.Id, pb.Session_Status_COMPLETED, nil)
+		test.Require().NoError(err)
+
+		message := newMessage(stream.MessageStatusCanceled)
+
+		err = command.SessionRunFinished(context.TODO(), message)
+		test.Require().NoError(err)
+
+		session, err := sessions.Get(context.TODO(), test.session.Id)
+		test.Require().NoError(err)
+		test.Equal(pb.Session_Status_COMPLETED, session.Statuses[0].Status)
+	})
+}
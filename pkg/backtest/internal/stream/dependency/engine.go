@@ -10,11 +10,14 @@ import (
 	"github.com/lhjnilsson/foreverbull/internal/container"
 	"github.com/lhjnilsson/foreverbull/internal/environment"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
+	"github.com/lhjnilsson/foreverbull/pkg/backtest/engine"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/backtest"
 )
 
 const GetEngineKey stream.Dependency = "get_engine"
 
+var Engine = stream.NewMethod[engine.Engine](GetEngineKey) //nolint: gochecknoglobals
+
 const (
 	NumberOfTries = 30
 	WaitTime      = time.Second / 3
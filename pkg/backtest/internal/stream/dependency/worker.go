@@ -0,0 +1,10 @@
+package dependency
+
+import (
+	"github.com/lhjnilsson/foreverbull/internal/stream"
+	"github.com/lhjnilsson/foreverbull/pkg/service/worker"
+)
+
+const WorkerBrokerDep stream.Dependency = "worker_broker"
+
+var WorkerBroker = stream.NewSingleton[*worker.Broker](WorkerBrokerDep) //nolint: gochecknoglobals
@@ -18,7 +18,10 @@ import (
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/servicer"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/stream/command"
 	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/stream/dependency"
+	ss "github.com/lhjnilsson/foreverbull/pkg/backtest/stream"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/backtest"
+	service_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
+	"github.com/lhjnilsson/foreverbull/pkg/service/worker"
 	"github.com/nats-io/nats.go"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
@@ -33,16 +36,18 @@ type (
 
 var Module = fx.Options( //nolint: gochecknoglobals
 	fx.Provide(
-		func(conn *pgxpool.Pool, st storage.Storage, ce container.Engine) (DependecyContainer, error) {
+		worker.NewBroker,
+		func(conn *pgxpool.Pool, st storage.Storage, ce container.Engine, broker *worker.Broker) (DependecyContainer, error) {
 			dc := stream.NewDependencyContainer()
 			dc.AddSingleton(stream.DBDep, conn)
 			dc.AddSingleton(stream.StorageDep, st)
 			dc.AddSingleton(stream.ContainerEngineDep, ce)
+			dc.AddSingleton(dependency.WorkerBrokerDep, broker)
 			// dc.AddMethod(dependency.GetEngineKey, dependency.GetEngine)
 			return dc, nil
 		},
 		func(jt nats.JetStreamContext, conn *pgxpool.Pool, dc DependecyContainer) (Stream, error) {
-			s, err := stream.NewNATSStream(jt, StreamName, dc, conn)
+			s, err := stream.NewStream(jt, StreamName, dc, conn)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create stream: %w", err)
 			}
@@ -50,11 +55,12 @@ var Module = fx.Options( //nolint: gochecknoglobals
 		},
 	),
 	fx.Invoke(
-		func(g *grpc.Server, pgx *pgxpool.Pool, s Stream, st storage.Storage) error {
+		func(g *grpc.Server, pgx *pgxpool.Pool, s Stream, st storage.Storage, broker *worker.Broker) error {
 			backtestServer := servicer.NewBacktestServer(pgx, s)
 			pb.RegisterBacktestServicerServer(g, backtestServer)
 			ingestionServer := servicer.NewIngestionServer(s, st, pgx)
 			pb.RegisterIngestionServicerServer(g, ingestionServer)
+			service_pb.RegisterWorkerBrokerServer(g, broker)
 			return nil
 		},
 		func(conn *pgxpool.Pool) error {
@@ -87,22 +93,31 @@ var Module = fx.Options( //nolint: gochecknoglobals
 					if err != nil {
 						return fmt.Errorf("error creating zipline engine: %w", err)
 					}
-					returnEngine := func(ctx context.Context, msg stream.Message) (interface{}, error) {
+					dependency.Engine.Provide(dependencies, func(ctx context.Context, msg stream.Message) (engine.Engine, error) {
 						return backtestEngine, nil
-					}
-					dependencies.AddMethod(dependency.GetEngineKey, returnEngine)
+					})
 
-					err = backtestStream.CommandSubscriber("ingest", "ingest", command.Ingest)
+					registry := stream.NewRegistry(StreamName, backtestStream, dependencies)
+					err = stream.Register(registry, ss.Ingest, command.Ingest,
+						stream.WithRetryPolicy(stream.ExponentialRetryPolicy(3, 5*time.Second, time.Minute)))
+					if err != nil {
+						return fmt.Errorf("error registering backtest.ingest: %w", err)
+					}
+					err = stream.Register(registry, ss.SessionRun, command.SessionRun)
+					if err != nil {
+						return fmt.Errorf("error registering backtest.session.run: %w", err)
+					}
+					err = stream.Register(registry, ss.UpdateIngestionStatus, command.UpdateIngestionStatus)
 					if err != nil {
-						return fmt.Errorf("error subscribing to backtest.ingest: %w", err)
+						return fmt.Errorf("error registering backtest.status.update: %w", err)
 					}
-					err = backtestStream.CommandSubscriber("session", "run", command.SessionRun)
+					err = stream.Register(registry, ss.RemoveIngestion, command.RemoveIngestion)
 					if err != nil {
-						return fmt.Errorf("error subscribing to backtest.start: %w", err)
+						return fmt.Errorf("error registering backtest.ingestion.remove: %w", err)
 					}
-					err = backtestStream.CommandSubscriber("status", "update", command.UpdateIngestionStatus)
+					err = backtestStream.EventSubscriber("backtest", "session", "run", command.SessionRunFinished)
 					if err != nil {
-						return fmt.Errorf("error subscribing to ingestion.update: %w", err)
+						return fmt.Errorf("error subscribing to backtest.session.run events: %w", err)
 					}
 					return nil
 				},
@@ -13,6 +13,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lhjnilsson/foreverbull/internal/container"
 	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/lhjnilsson/foreverbull/internal/socket"
 	"github.com/lhjnilsson/foreverbull/internal/storage"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 	"github.com/lhjnilsson/foreverbull/internal/test_helper"
@@ -24,6 +25,7 @@ import (
 	service_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
 	"github.com/lhjnilsson/foreverbull/pkg/service"
 	"github.com/stretchr/testify/suite"
+	"go.nanomsg.org/mangos/v3"
 	"go.nanomsg.org/mangos/v3/protocol/rep"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
@@ -208,9 +210,22 @@ func (test *BacktestModuleTest) TestBacktestModule() {
 	})
 	test.Require().NoError(err, "failed to create execution")
 
+	// Worker sockets always require the credentials of the execution
+	credentials := excRep.Configuration.GetCredentials()
+	test.Require().NotNil(credentials)
+
+	clientTLS, err := (&socket.Credentials{
+		ServerName: credentials.ServerName,
+		CA:         credentials.CaCertificate,
+		ClientCert: credentials.Certificate,
+		ClientKey:  credentials.PrivateKey,
+	}).ClientTLS()
+	test.Require().NoError(err)
+
 	workerSocket, err := rep.NewSocket()
 	test.Require().NoError(err)
-	err = workerSocket.Dial(fmt.Sprintf("tcp://127.0.0.1:%d", excRep.Configuration.GetBrokerPort()))
+	err = workerSocket.DialOptions(fmt.Sprintf("tls+tcp://127.0.0.1:%d", excRep.Configuration.GetBrokerPort()),
+		map[string]interface{}{mangos.OptionTLSConfig: clientTLS})
 	test.Require().NoError(err, "Failed to connect to broker port ", excRep.Configuration.GetBrokerPort())
 
 	go runner(workerSocket)
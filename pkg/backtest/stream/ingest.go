@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/lhjnilsson/foreverbull/internal/stream"
+	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/stream/dependency"
 	financeStream "github.com/lhjnilsson/foreverbull/pkg/finance/stream"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/backtest"
 )
@@ -15,20 +16,16 @@ type IngestCommand struct {
 	End     string
 }
 
+var Ingest = stream.NewCommand[IngestCommand]("backtest", "ingest", "ingest", //nolint: gochecknoglobals
+	stream.Storage, dependency.Engine)
+
 func NewBacktestIngestCommand(name string, symbols []string, start, end string) (stream.Message, error) {
-	cmd := &IngestCommand{
+	return Ingest.NewMessage(IngestCommand{
 		Name:    name,
 		Symbols: symbols,
 		Start:   start,
 		End:     end,
-	}
-
-	msg, err := stream.NewMessage("backtest", "ingest", "ingest", cmd)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
+	})
 }
 
 type UpdateStatusCommand struct {
@@ -36,37 +33,75 @@ type UpdateStatusCommand struct {
 	Status pb.IngestionStatus
 }
 
+var UpdateIngestionStatus = stream.NewCommand[UpdateStatusCommand]("backtest", "status", "update", //nolint: gochecknoglobals
+	stream.Storage)
+
 func NewUpdateIngestionStatusCommand(name string, status pb.IngestionStatus) (stream.Message, error) {
-	cmd := &UpdateStatusCommand{
+	return UpdateIngestionStatus.NewMessage(UpdateStatusCommand{
 		Name:   name,
 		Status: status,
-	}
+	})
+}
 
-	msg, err := stream.NewMessage("backtest", "status", "update", cmd)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-	return msg, nil
+type RemoveIngestionCommand struct {
+	Name string
 }
 
+var RemoveIngestion = stream.NewCommand[RemoveIngestionCommand]("backtest", "ingestion", "remove", //nolint: gochecknoglobals
+	stream.Storage)
+
+func NewRemoveIngestionCommand(name string) (stream.Message, error) {
+	return RemoveIngestion.NewMessage(RemoveIngestionCommand{
+		Name: name,
+	})
+}
+
+const ingestBatchSize = 10
+
 func NewIngestOrchestration(name string, symbols []string, start, end string) (*stream.MessageOrchestration, error) {
 	orchestration := stream.NewMessageOrchestration("ingest backtest")
 
-	msg, err := financeStream.NewIngestCommand(symbols, start, &end)
+	msg, err := NewUpdateIngestionStatusCommand(name, pb.IngestionStatus_DOWNLOADING)
 	if err != nil {
 		return nil, fmt.Errorf("error creating message: %w", err)
 	}
-	msg1, err := NewUpdateIngestionStatusCommand(name, pb.IngestionStatus_DOWNLOADING)
+	err = orchestration.AddDependentStep("update status", nil, []stream.Message{msg})
+	if err != nil {
+		return nil, fmt.Errorf("error adding step: %w", err)
+	}
+
+	msg, err = NewRemoveIngestionCommand(name)
 	if err != nil {
 		return nil, fmt.Errorf("error creating message: %w", err)
 	}
-	orchestration.AddStep("ingest financial data", []stream.Message{msg, msg1})
+	err = orchestration.AddCompensation("update status", []stream.Message{msg})
+	if err != nil {
+		return nil, fmt.Errorf("error adding compensation: %w", err)
+	}
+
+	ingestSteps := []string{"update status"}
+	for batch := 0; batch*ingestBatchSize < len(symbols); batch++ {
+		batchSymbols := symbols[batch*ingestBatchSize : min((batch+1)*ingestBatchSize, len(symbols))]
+		msg, err = financeStream.NewIngestCommand(batchSymbols, start, &end)
+		if err != nil {
+			return nil, fmt.Errorf("error creating message: %w", err)
+		}
+		step := fmt.Sprintf("ingest financial data %d", batch)
+		err = orchestration.AddDependentStep(step, nil, []stream.Message{msg})
+		if err != nil {
+			return nil, fmt.Errorf("error adding step: %w", err)
+		}
+		ingestSteps = append(ingestSteps, step)
+	}
 
 	msg, err = NewBacktestIngestCommand(name, symbols, start, end)
 	if err != nil {
 		return nil, fmt.Errorf("error creating message: %w", err)
 	}
-	orchestration.AddStep("ingest into backtest", []stream.Message{msg})
+	err = orchestration.AddDependentStep("ingest into backtest", ingestSteps, []stream.Message{msg})
+	if err != nil {
+		return nil, fmt.Errorf("error adding step: %w", err)
+	}
 
 	msg, err = NewUpdateIngestionStatusCommand(name, pb.IngestionStatus_ERROR)
 	if err != nil {
@@ -0,0 +1,130 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lhjnilsson/foreverbull/internal/stream"
+	financeStream "github.com/lhjnilsson/foreverbull/pkg/finance/stream"
+	"github.com/stretchr/testify/suite"
+)
+
+type IngestOrchestrationTest struct {
+	suite.Suite
+
+	finance  stream.Stream
+	backtest stream.Stream
+
+	lock      sync.Mutex
+	executed  []string
+	symbols   []string
+	done      chan struct{}
+	ingestErr error
+}
+
+func TestIngestOrchestration(t *testing.T) {
+	suite.Run(t, new(IngestOrchestrationTest))
+}
+
+func (test *IngestOrchestrationTest) SetupTest() {
+	bus := stream.NewMemoryBus()
+	test.finance = stream.NewMemoryStream(bus, "finance", stream.NewDependencyContainer())
+	test.backtest = stream.NewMemoryStream(bus, "backtest", stream.NewDependencyContainer())
+	test.executed = nil
+	test.symbols = nil
+	test.done = make(chan struct{})
+	test.ingestErr = nil
+
+	test.Require().NoError(test.finance.CommandSubscriber("marketdata", "ingest", func(ctx context.Context, msg stream.Message) error {
+		cmd := financeStream.IngestCommand{}
+		if err := msg.ParsePayload(&cmd); err != nil {
+			return err
+		}
+		test.lock.Lock()
+		defer test.lock.Unlock()
+		test.executed = append(test.executed, "finance ingest")
+		test.symbols = append(test.symbols, cmd.Symbols...)
+		return nil
+	}))
+	test.Require().NoError(test.backtest.CommandSubscriber("status", "update", func(ctx context.Context, msg stream.Message) error {
+		cmd := UpdateStatusCommand{}
+		if err := msg.ParsePayload(&cmd); err != nil {
+			return err
+		}
+		test.lock.Lock()
+		defer test.lock.Unlock()
+		test.executed = append(test.executed, "status "+cmd.Status.String())
+		return nil
+	}))
+	test.Require().NoError(test.backtest.CommandSubscriber("ingest", "ingest", func(ctx context.Context, msg stream.Message) error {
+		test.lock.Lock()
+		defer test.lock.Unlock()
+		test.executed = append(test.executed, "backtest ingest")
+		if test.ingestErr != nil {
+			return test.ingestErr
+		}
+		close(test.done)
+		return nil
+	}))
+	test.Require().NoError(test.backtest.CommandSubscriber("ingestion", "remove", func(ctx context.Context, msg stream.Message) error {
+		cmd := RemoveIngestionCommand{}
+		if err := msg.ParsePayload(&cmd); err != nil {
+			return err
+		}
+		test.lock.Lock()
+		defer test.lock.Unlock()
+		test.executed = append(test.executed, "remove "+cmd.Name)
+		return nil
+	}))
+}
+
+func (test *IngestOrchestrationTest) TearDownTest() {
+	test.NoError(test.finance.Unsubscribe())
+	test.NoError(test.backtest.Unsubscribe())
+}
+
+func (test *IngestOrchestrationTest) TestIngest() {
+	symbols := make([]string, 25)
+	for i := range symbols {
+		symbols[i] = fmt.Sprintf("SYM%d", i)
+	}
+
+	orchestration, err := NewIngestOrchestration("test", symbols, "2020-01-01", "2020-12-31")
+	test.Require().NoError(err)
+	test.Require().NoError(test.backtest.RunOrchestration(context.Background(), orchestration))
+
+	select {
+	case <-test.done:
+	case <-time.After(time.Second * 5):
+		test.Fail("orchestration did not complete")
+	}
+
+	test.lock.Lock()
+	defer test.lock.Unlock()
+	test.ElementsMatch(symbols, test.symbols)
+	test.Len(test.executed, 5)
+	test.ElementsMatch([]string{"status DOWNLOADING", "finance ingest", "finance ingest", "finance ingest"}, test.executed[:4])
+	test.Equal("backtest ingest", test.executed[4])
+}
+
+func (test *IngestOrchestrationTest) TestIngestFailed() {
+	test.ingestErr = errors.New("ingest failed")
+
+	orchestration, err := NewIngestOrchestration("test", []string{"SYM"}, "2020-01-01", "2020-12-31")
+	test.Require().NoError(err)
+	test.Require().NoError(test.backtest.RunOrchestration(context.Background(), orchestration))
+
+	test.Eventually(func() bool {
+		test.lock.Lock()
+		defer test.lock.Unlock()
+		return len(test.executed) == 5
+	}, time.Second*5, time.Millisecond*10)
+
+	test.lock.Lock()
+	defer test.lock.Unlock()
+	test.Equal([]string{"remove test", "status ERROR"}, test.executed[3:])
+}
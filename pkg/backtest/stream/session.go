@@ -1,33 +1,10 @@
 package stream
 
 import (
-	"fmt"
-
 	"github.com/lhjnilsson/foreverbull/internal/stream"
-	pb "github.com/lhjnilsson/foreverbull/pkg/pb/backtest"
+	"github.com/lhjnilsson/foreverbull/pkg/backtest/internal/stream/dependency"
 )
 
-type UpdateSessionStatusCommand struct {
-	SessionID string
-	Status    pb.Session_Status_Status
-	Error     error
-}
-
-func NewUpdateSessionStatusCommand(session string, status pb.Session_Status_Status, err error) (stream.Message, error) {
-	entity := &UpdateSessionStatusCommand{
-		SessionID: session,
-		Status:    status,
-		Error:     err,
-	}
-
-	msg, err := stream.NewMessage("backtest", "session", "status", entity)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
-}
-
 type SessionRunCommand struct {
 	Backtest           string
 	SessionID          string
@@ -36,16 +13,12 @@ type SessionRunCommand struct {
 	WorkerInstanceIDs  []string
 }
 
+var SessionRun = stream.NewCommand[SessionRunCommand]("backtest", "session", "run", //nolint: gochecknoglobals
+	stream.DB, stream.Storage, dependency.Engine, dependency.WorkerBroker)
+
 func NewSessionRunCommand(backtest, sessionID string) (stream.Message, error) {
-	entity := &SessionRunCommand{
+	return SessionRun.NewMessage(SessionRunCommand{
 		Backtest:  backtest,
 		SessionID: sessionID,
-	}
-
-	msg, err := stream.NewMessage("backtest", "session", "run", entity)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
+	})
 }
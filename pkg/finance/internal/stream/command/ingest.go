@@ -7,30 +7,21 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/lhjnilsson/foreverbull/internal/postgres"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 	"github.com/lhjnilsson/foreverbull/pkg/finance/internal/repository"
 	"github.com/lhjnilsson/foreverbull/pkg/finance/internal/stream/dependency"
 	fs "github.com/lhjnilsson/foreverbull/pkg/finance/stream"
-	"github.com/lhjnilsson/foreverbull/pkg/finance/supplier"
 )
 
-func Ingest(ctx context.Context, message stream.Message) error {
-	postgres, isDB := message.MustGet(stream.DBDep).(postgres.Query)
-	if !isDB {
-		return errors.New("db dependency casting failed")
-	}
-
-	marketdata, isMd := message.MustGet(dependency.MarketDataDep).(supplier.Marketdata)
-	if !isMd {
-		return errors.New("marketdata dependency casting failed")
+func Ingest(ctx context.Context, message stream.Message, command fs.IngestCommand) error {
+	postgres, err := stream.DB.Get(message)
+	if err != nil {
+		return fmt.Errorf("error getting db: %w", err)
 	}
 
-	command := fs.IngestCommand{}
-
-	err := message.ParsePayload(&command)
+	marketdata, err := dependency.MarketData.Get(message)
 	if err != nil {
-		return fmt.Errorf("error unmarshalling OHLCIngest payload: %w", err)
+		return fmt.Errorf("error getting marketdata: %w", err)
 	}
 
 	assets := repository.Asset{Conn: postgres}
@@ -38,7 +29,7 @@ func Ingest(ctx context.Context, message stream.Message) error {
 
 	start, err := time.Parse("2006-01-02", command.Start)
 	if err != nil {
-		return fmt.Errorf("error parsing start date: %w", err)
+		return stream.NonRetryable(fmt.Errorf("error parsing start date: %w", err))
 	}
 
 	var end *time.Time
@@ -46,7 +37,7 @@ func Ingest(ctx context.Context, message stream.Message) error {
 	if command.End != nil {
 		e, err := time.Parse("2006-01-02", *command.End)
 		if err != nil {
-			return fmt.Errorf("error parsing end date: %w", err)
+			return stream.NonRetryable(fmt.Errorf("error parsing end date: %w", err))
 		}
 
 		end = &e
@@ -100,15 +100,14 @@ func (test *IngestCommandTest) TestIngestCommandIngest() {
 		nil,
 	)
 
-	message.On("ParsePayload", &fs.IngestCommand{}).Return(nil).Run(func(args mock.Arguments) {
-		command := args.Get(0).(*fs.IngestCommand)
-		command.Symbols = []string{"NEW123"}
-		command.Start = internal_pb.DateToDateString(test.storedOHLCStart)
-		end := internal_pb.DateToDateString(test.storedOHLCEnd)
-		command.End = &end
-	})
+	end := internal_pb.DateToDateString(test.storedOHLCEnd)
+	payload := fs.IngestCommand{
+		Symbols: []string{"NEW123"},
+		Start:   internal_pb.DateToDateString(test.storedOHLCStart),
+		End:     &end,
+	}
 
-	err := command.Ingest(context.Background(), message)
+	err := command.Ingest(context.Background(), message, payload)
 	test.Require().NoError(err)
 
 	asset, err := test.assets.Get(context.Background(), newAsset.Symbol)
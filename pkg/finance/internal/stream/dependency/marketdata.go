@@ -1,7 +1,12 @@
 package dependency
 
-import "github.com/lhjnilsson/foreverbull/internal/stream"
+import (
+	"github.com/lhjnilsson/foreverbull/internal/stream"
+	"github.com/lhjnilsson/foreverbull/pkg/finance/supplier"
+)
 
 const (
 	MarketDataDep stream.Dependency = "marketdata"
 )
+
+var MarketData = stream.NewSingleton[supplier.Marketdata](MarketDataDep) //nolint: gochecknoglobals
@@ -3,6 +3,7 @@ package finance
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lhjnilsson/foreverbull/internal/environment"
@@ -13,6 +14,7 @@ import (
 	"github.com/lhjnilsson/foreverbull/pkg/finance/internal/stream/dependency"
 	"github.com/lhjnilsson/foreverbull/pkg/finance/internal/suppliers/marketdata"
 	"github.com/lhjnilsson/foreverbull/pkg/finance/internal/suppliers/trading"
+	fs "github.com/lhjnilsson/foreverbull/pkg/finance/stream"
 	"github.com/lhjnilsson/foreverbull/pkg/finance/supplier"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/finance"
 	"github.com/nats-io/nats.go"
@@ -22,7 +24,14 @@ import (
 
 const StreamName = "finance"
 
-type Stream stream.Stream
+// ingestConcurrency bounds concurrent ingestions, each one queries the market data
+// provider and holds a database connection.
+const ingestConcurrency = 2
+
+type (
+	Stream              stream.Stream
+	DependencyContainer stream.DependencyContainer
+)
 
 var Module = fx.Options( //nolint: gochecknoglobals
 	fx.Provide(
@@ -44,11 +53,14 @@ var Module = fx.Options( //nolint: gochecknoglobals
 			}
 			return marketData, t, nil
 		},
-		func(jt nats.JetStreamContext, conn *pgxpool.Pool, marketData supplier.Marketdata) (Stream, error) {
+		func(conn *pgxpool.Pool, marketData supplier.Marketdata) DependencyContainer {
 			dc := stream.NewDependencyContainer()
 			dc.AddSingleton(stream.DBDep, conn)
 			dc.AddSingleton(dependency.MarketDataDep, marketData)
-			s, err := stream.NewNATSStream(jt, StreamName, dc, conn)
+			return dc
+		},
+		func(jt nats.JetStreamContext, conn *pgxpool.Pool, dc DependencyContainer) (Stream, error) {
+			s, err := stream.NewStream(jt, StreamName, dc, conn)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create stream: %w", err)
 			}
@@ -66,17 +78,20 @@ var Module = fx.Options( //nolint: gochecknoglobals
 		func(conn *pgxpool.Pool) error {
 			return repository.CreateTables(context.Background(), conn)
 		},
-		func(lc fx.Lifecycle, stream Stream) error {
+		func(lc fx.Lifecycle, financeStream Stream, dependencies DependencyContainer) error {
 			lc.Append(fx.Hook{
 				OnStart: func(ctx context.Context) error {
-					err := stream.CommandSubscriber("marketdata", "ingest", command.Ingest)
+					registry := stream.NewRegistry(StreamName, financeStream, dependencies)
+					err := stream.Register(registry, fs.Ingest, command.Ingest,
+						stream.WithRetryPolicy(stream.ExponentialRetryPolicy(3, 5*time.Second, time.Minute)),
+						stream.WithConcurrency(ingestConcurrency))
 					if err != nil {
 						return fmt.Errorf("failed to subscribe to ingest command: %w", err)
 					}
 					return nil
 				},
 				OnStop: func(ctx context.Context) error {
-					return stream.Unsubscribe()
+					return financeStream.Unsubscribe()
 				},
 			})
 			return nil
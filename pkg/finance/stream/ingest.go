@@ -1,9 +1,8 @@
 package stream
 
 import (
-	"fmt"
-
 	"github.com/lhjnilsson/foreverbull/internal/stream"
+	"github.com/lhjnilsson/foreverbull/pkg/finance/internal/stream/dependency"
 )
 
 type IngestCommand struct {
@@ -12,17 +11,13 @@ type IngestCommand struct {
 	End     *string  `json:"end"`
 }
 
+var Ingest = stream.NewCommand[IngestCommand]("finance", "marketdata", "ingest", //nolint: gochecknoglobals
+	stream.DB, dependency.MarketData)
+
 func NewIngestCommand(symbols []string, start string, end *string) (stream.Message, error) {
-	entity := &IngestCommand{
+	return Ingest.NewMessage(IngestCommand{
 		Symbols: symbols,
 		Start:   start,
 		End:     end,
-	}
-
-	msg, err := stream.NewMessage("finance", "marketdata", "ingest", entity)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
+	})
 }
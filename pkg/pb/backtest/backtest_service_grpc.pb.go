@@ -19,13 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BacktestServicer_ListBacktests_FullMethodName  = "/foreverbull.backtest.BacktestServicer/ListBacktests"
-	BacktestServicer_CreateBacktest_FullMethodName = "/foreverbull.backtest.BacktestServicer/CreateBacktest"
-	BacktestServicer_GetBacktest_FullMethodName    = "/foreverbull.backtest.BacktestServicer/GetBacktest"
-	BacktestServicer_CreateSession_FullMethodName  = "/foreverbull.backtest.BacktestServicer/CreateSession"
-	BacktestServicer_GetSession_FullMethodName     = "/foreverbull.backtest.BacktestServicer/GetSession"
-	BacktestServicer_ListExecutions_FullMethodName = "/foreverbull.backtest.BacktestServicer/ListExecutions"
-	BacktestServicer_GetExecution_FullMethodName   = "/foreverbull.backtest.BacktestServicer/GetExecution"
+	BacktestServicer_ListBacktests_FullMethodName         = "/foreverbull.backtest.BacktestServicer/ListBacktests"
+	BacktestServicer_CreateBacktest_FullMethodName        = "/foreverbull.backtest.BacktestServicer/CreateBacktest"
+	BacktestServicer_GetBacktest_FullMethodName           = "/foreverbull.backtest.BacktestServicer/GetBacktest"
+	BacktestServicer_CreateSession_FullMethodName         = "/foreverbull.backtest.BacktestServicer/CreateSession"
+	BacktestServicer_GetSession_FullMethodName            = "/foreverbull.backtest.BacktestServicer/GetSession"
+	BacktestServicer_ListExecutions_FullMethodName        = "/foreverbull.backtest.BacktestServicer/ListExecutions"
+	BacktestServicer_GetExecution_FullMethodName          = "/foreverbull.backtest.BacktestServicer/GetExecution"
+	BacktestServicer_GetExecutionNamespace_FullMethodName = "/foreverbull.backtest.BacktestServicer/GetExecutionNamespace"
+	BacktestServicer_ListPortLeases_FullMethodName        = "/foreverbull.backtest.BacktestServicer/ListPortLeases"
 )
 
 // BacktestServicerClient is the client API for BacktestServicer service.
@@ -39,6 +41,8 @@ type BacktestServicerClient interface {
 	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
 	ListExecutions(ctx context.Context, in *ListExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsResponse, error)
 	GetExecution(ctx context.Context, in *GetExecutionRequest, opts ...grpc.CallOption) (*GetExecutionResponse, error)
+	GetExecutionNamespace(ctx context.Context, in *GetExecutionNamespaceRequest, opts ...grpc.CallOption) (*GetExecutionNamespaceResponse, error)
+	ListPortLeases(ctx context.Context, in *ListPortLeasesRequest, opts ...grpc.CallOption) (*ListPortLeasesResponse, error)
 }
 
 type backtestServicerClient struct {
@@ -119,6 +123,26 @@ func (c *backtestServicerClient) GetExecution(ctx context.Context, in *GetExecut
 	return out, nil
 }
 
+func (c *backtestServicerClient) GetExecutionNamespace(ctx context.Context, in *GetExecutionNamespaceRequest, opts ...grpc.CallOption) (*GetExecutionNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExecutionNamespaceResponse)
+	err := c.cc.Invoke(ctx, BacktestServicer_GetExecutionNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtestServicerClient) ListPortLeases(ctx context.Context, in *ListPortLeasesRequest, opts ...grpc.CallOption) (*ListPortLeasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPortLeasesResponse)
+	err := c.cc.Invoke(ctx, BacktestServicer_ListPortLeases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BacktestServicerServer is the server API for BacktestServicer service.
 // All implementations must embed UnimplementedBacktestServicerServer
 // for forward compatibility.
@@ -130,6 +154,8 @@ type BacktestServicerServer interface {
 	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
 	ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error)
 	GetExecution(context.Context, *GetExecutionRequest) (*GetExecutionResponse, error)
+	GetExecutionNamespace(context.Context, *GetExecutionNamespaceRequest) (*GetExecutionNamespaceResponse, error)
+	ListPortLeases(context.Context, *ListPortLeasesRequest) (*ListPortLeasesResponse, error)
 	mustEmbedUnimplementedBacktestServicerServer()
 }
 
@@ -161,6 +187,12 @@ func (UnimplementedBacktestServicerServer) ListExecutions(context.Context, *List
 func (UnimplementedBacktestServicerServer) GetExecution(context.Context, *GetExecutionRequest) (*GetExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExecution not implemented")
 }
+func (UnimplementedBacktestServicerServer) GetExecutionNamespace(context.Context, *GetExecutionNamespaceRequest) (*GetExecutionNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionNamespace not implemented")
+}
+func (UnimplementedBacktestServicerServer) ListPortLeases(context.Context, *ListPortLeasesRequest) (*ListPortLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPortLeases not implemented")
+}
 func (UnimplementedBacktestServicerServer) mustEmbedUnimplementedBacktestServicerServer() {}
 func (UnimplementedBacktestServicerServer) testEmbeddedByValue()                          {}
 
@@ -308,6 +340,42 @@ func _BacktestServicer_GetExecution_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BacktestServicer_GetExecutionNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktestServicerServer).GetExecutionNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktestServicer_GetExecutionNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktestServicerServer).GetExecutionNamespace(ctx, req.(*GetExecutionNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktestServicer_ListPortLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktestServicerServer).ListPortLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktestServicer_ListPortLeases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktestServicerServer).ListPortLeases(ctx, req.(*ListPortLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BacktestServicer_ServiceDesc is the grpc.ServiceDesc for BacktestServicer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +411,14 @@ var BacktestServicer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExecution",
 			Handler:    _BacktestServicer_GetExecution_Handler,
 		},
+		{
+			MethodName: "GetExecutionNamespace",
+			Handler:    _BacktestServicer_GetExecutionNamespace_Handler,
+		},
+		{
+			MethodName: "ListPortLeases",
+			Handler:    _BacktestServicer_ListPortLeases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "foreverbull/backtest/backtest_service.proto",
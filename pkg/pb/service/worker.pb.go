@@ -7,8 +7,10 @@
 package service
 
 import (
+	finance "github.com/lhjnilsson/foreverbull/pkg/pb/finance"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +22,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// NamespaceScope decides how long namespace values are kept, period scoped namespaces
+// are cleared before every period.
+type NamespaceScope int32
+
+const (
+	NamespaceScope_PERIOD    NamespaceScope = 0
+	NamespaceScope_EXECUTION NamespaceScope = 1
+	NamespaceScope_SESSION   NamespaceScope = 2
+)
+
+// Enum value maps for NamespaceScope.
+var (
+	NamespaceScope_name = map[int32]string{
+		0: "PERIOD",
+		1: "EXECUTION",
+		2: "SESSION",
+	}
+	NamespaceScope_value = map[string]int32{
+		"PERIOD":    0,
+		"EXECUTION": 1,
+		"SESSION":   2,
+	}
+)
+
+func (x NamespaceScope) Enum() *NamespaceScope {
+	p := new(NamespaceScope)
+	*p = x
+	return p
+}
+
+func (x NamespaceScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NamespaceScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_foreverbull_service_worker_proto_enumTypes[0].Descriptor()
+}
+
+func (NamespaceScope) Type() protoreflect.EnumType {
+	return &file_foreverbull_service_worker_proto_enumTypes[0]
+}
+
+func (x NamespaceScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NamespaceScope.Descriptor instead.
+func (NamespaceScope) EnumDescriptor() ([]byte, []int) {
+	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{0}
+}
+
+// WorkerTransport decides how workers reach the pool.
+type WorkerTransport int32
+
+const (
+	// Nanomsg sockets on brokerPort and namespacePort.
+	WorkerTransport_MANGOS WorkerTransport = 0
+	// WorkerBroker service on the server gRPC port, see worker_service.proto.
+	WorkerTransport_GRPC WorkerTransport = 1
+)
+
+// Enum value maps for WorkerTransport.
+var (
+	WorkerTransport_name = map[int32]string{
+		0: "MANGOS",
+		1: "GRPC",
+	}
+	WorkerTransport_value = map[string]int32{
+		"MANGOS": 0,
+		"GRPC":   1,
+	}
+)
+
+func (x WorkerTransport) Enum() *WorkerTransport {
+	p := new(WorkerTransport)
+	*p = x
+	return p
+}
+
+func (x WorkerTransport) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkerTransport) Descriptor() protoreflect.EnumDescriptor {
+	return file_foreverbull_service_worker_proto_enumTypes[1].Descriptor()
+}
+
+func (WorkerTransport) Type() protoreflect.EnumType {
+	return &file_foreverbull_service_worker_proto_enumTypes[1]
+}
+
+func (x WorkerTransport) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkerTransport.Descriptor instead.
+func (WorkerTransport) EnumDescriptor() ([]byte, []int) {
+	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{1}
+}
+
 type Algorithm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -28,6 +130,8 @@ type Algorithm struct {
 	FilePath   string                `protobuf:"bytes,1,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
 	Functions  []*Algorithm_Function `protobuf:"bytes,2,rep,name=functions,proto3" json:"functions,omitempty"`
 	Namespaces []string              `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Scope of the namespaces, namespaces without a scope are period scoped.
+	NamespaceScopes map[string]NamespaceScope `protobuf:"bytes,4,rep,name=namespace_scopes,json=namespaceScopes,proto3" json:"namespace_scopes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=foreverbull.service.NamespaceScope"`
 }
 
 func (x *Algorithm) Reset() {
@@ -83,6 +187,13 @@ func (x *Algorithm) GetNamespaces() []string {
 	return nil
 }
 
+func (x *Algorithm) GetNamespaceScopes() map[string]NamespaceScope {
+	if x != nil {
+		return x.NamespaceScopes
+	}
+	return nil
+}
+
 type ExecutionConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -90,8 +201,17 @@ type ExecutionConfiguration struct {
 
 	BrokerPort    int32                              `protobuf:"varint,1,opt,name=brokerPort,proto3" json:"brokerPort,omitempty"`
 	NamespacePort int32                              `protobuf:"varint,2,opt,name=namespacePort,proto3" json:"namespacePort,omitempty"`
-	DatabaseURL   string                             `protobuf:"bytes,3,opt,name=databaseURL,proto3" json:"databaseURL,omitempty"`
 	Functions     []*ExecutionConfiguration_Function `protobuf:"bytes,4,rep,name=functions,proto3" json:"functions,omitempty"`
+	// Port of the broadcast socket, 0 when nothing is broadcasted.
+	BroadcastPort int32           `protobuf:"varint,5,opt,name=broadcastPort,proto3" json:"broadcastPort,omitempty"`
+	Transport     WorkerTransport `protobuf:"varint,6,opt,name=transport,proto3,enum=foreverbull.service.WorkerTransport" json:"transport,omitempty"`
+	// Pool to connect to with the GRPC transport.
+	Pool string `protobuf:"bytes,7,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Set when the pool and broadcast sockets require TLS, connections without these
+	// credentials are rejected.
+	Credentials *ExecutionConfiguration_Credentials `protobuf:"bytes,8,opt,name=credentials,proto3,oneof" json:"credentials,omitempty"`
+	// Token every GRPC transport call must carry alongside the pool.
+	PoolToken string `protobuf:"bytes,9,opt,name=poolToken,proto3" json:"poolToken,omitempty"`
 }
 
 func (x *ExecutionConfiguration) Reset() {
@@ -140,20 +260,151 @@ func (x *ExecutionConfiguration) GetNamespacePort() int32 {
 	return 0
 }
 
-func (x *ExecutionConfiguration) GetDatabaseURL() string {
+func (x *ExecutionConfiguration) GetFunctions() []*ExecutionConfiguration_Function {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+func (x *ExecutionConfiguration) GetBroadcastPort() int32 {
+	if x != nil {
+		return x.BroadcastPort
+	}
+	return 0
+}
+
+func (x *ExecutionConfiguration) GetTransport() WorkerTransport {
+	if x != nil {
+		return x.Transport
+	}
+	return WorkerTransport_MANGOS
+}
+
+func (x *ExecutionConfiguration) GetPool() string {
 	if x != nil {
-		return x.DatabaseURL
+		return x.Pool
 	}
 	return ""
 }
 
-func (x *ExecutionConfiguration) GetFunctions() []*ExecutionConfiguration_Function {
+func (x *ExecutionConfiguration) GetCredentials() *ExecutionConfiguration_Credentials {
 	if x != nil {
-		return x.Functions
+		return x.Credentials
 	}
 	return nil
 }
 
+func (x *ExecutionConfiguration) GetPoolToken() string {
+	if x != nil {
+		return x.PoolToken
+	}
+	return ""
+}
+
+// Broadcast is published on the broadcast socket for passive listeners.
+type Broadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are assignable to Payload:
+	//	*Broadcast_Portfolio
+	//	*Broadcast_Orders_
+	//	*Broadcast_Event_
+	Payload isBroadcast_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Broadcast) Reset() {
+	*x = Broadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_service_worker_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Broadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Broadcast) ProtoMessage() {}
+
+func (x *Broadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_service_worker_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Broadcast.ProtoReflect.Descriptor instead.
+func (*Broadcast) Descriptor() ([]byte, []int) {
+	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Broadcast) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (m *Broadcast) GetPayload() isBroadcast_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Broadcast) GetPortfolio() *finance.Portfolio {
+	if x, ok := x.GetPayload().(*Broadcast_Portfolio); ok {
+		return x.Portfolio
+	}
+	return nil
+}
+
+func (x *Broadcast) GetOrders() *Broadcast_Orders {
+	if x, ok := x.GetPayload().(*Broadcast_Orders_); ok {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *Broadcast) GetEvent() *Broadcast_Event {
+	if x, ok := x.GetPayload().(*Broadcast_Event_); ok {
+		return x.Event
+	}
+	return nil
+}
+
+type isBroadcast_Payload interface {
+	isBroadcast_Payload()
+}
+
+type Broadcast_Portfolio struct {
+	Portfolio *finance.Portfolio `protobuf:"bytes,2,opt,name=portfolio,proto3,oneof"`
+}
+
+type Broadcast_Orders_ struct {
+	Orders *Broadcast_Orders `protobuf:"bytes,3,opt,name=orders,proto3,oneof"`
+}
+
+type Broadcast_Event_ struct {
+	Event *Broadcast_Event `protobuf:"bytes,4,opt,name=event,proto3,oneof"`
+}
+
+func (*Broadcast_Portfolio) isBroadcast_Payload() {}
+
+func (*Broadcast_Orders_) isBroadcast_Payload() {}
+
+func (*Broadcast_Event_) isBroadcast_Payload() {}
+
 type Algorithm_FunctionParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -168,7 +419,7 @@ type Algorithm_FunctionParameter struct {
 func (x *Algorithm_FunctionParameter) Reset() {
 	*x = Algorithm_FunctionParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_foreverbull_service_worker_proto_msgTypes[2]
+		mi := &file_foreverbull_service_worker_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -181,7 +432,7 @@ func (x *Algorithm_FunctionParameter) String() string {
 func (*Algorithm_FunctionParameter) ProtoMessage() {}
 
 func (x *Algorithm_FunctionParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_foreverbull_service_worker_proto_msgTypes[2]
+	mi := &file_foreverbull_service_worker_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,12 +486,15 @@ type Algorithm_Function struct {
 	ParallelExecution bool                           `protobuf:"varint,3,opt,name=parallelExecution,proto3" json:"parallelExecution,omitempty"`
 	RunFirst          bool                           `protobuf:"varint,4,opt,name=runFirst,proto3" json:"runFirst,omitempty"`
 	RunLast           bool                           `protobuf:"varint,5,opt,name=runLast,proto3" json:"runLast,omitempty"`
+	// Functions that must complete before this function runs in a period, outputs
+	// are shared through the namespace.
+	DependsOn []string `protobuf:"bytes,6,rep,name=dependsOn,proto3" json:"dependsOn,omitempty"`
 }
 
 func (x *Algorithm_Function) Reset() {
 	*x = Algorithm_Function{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_foreverbull_service_worker_proto_msgTypes[3]
+		mi := &file_foreverbull_service_worker_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -253,7 +507,7 @@ func (x *Algorithm_Function) String() string {
 func (*Algorithm_Function) ProtoMessage() {}
 
 func (x *Algorithm_Function) ProtoReflect() protoreflect.Message {
-	mi := &file_foreverbull_service_worker_proto_msgTypes[3]
+	mi := &file_foreverbull_service_worker_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,6 +558,87 @@ func (x *Algorithm_Function) GetRunLast() bool {
 	return false
 }
 
+func (x *Algorithm_Function) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+// Credentials workers dial the pool sockets with over tls+tcp, certificates and
+// keys are PEM encoded.
+type ExecutionConfiguration_Credentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaCertificate []byte `protobuf:"bytes,1,opt,name=caCertificate,proto3" json:"caCertificate,omitempty"`
+	Certificate   []byte `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	PrivateKey    []byte `protobuf:"bytes,3,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	// Name the pool certificate is verified against.
+	ServerName string `protobuf:"bytes,4,opt,name=serverName,proto3" json:"serverName,omitempty"`
+}
+
+func (x *ExecutionConfiguration_Credentials) Reset() {
+	*x = ExecutionConfiguration_Credentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_service_worker_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionConfiguration_Credentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionConfiguration_Credentials) ProtoMessage() {}
+
+func (x *ExecutionConfiguration_Credentials) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_service_worker_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionConfiguration_Credentials.ProtoReflect.Descriptor instead.
+func (*ExecutionConfiguration_Credentials) Descriptor() ([]byte, []int) {
+	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ExecutionConfiguration_Credentials) GetCaCertificate() []byte {
+	if x != nil {
+		return x.CaCertificate
+	}
+	return nil
+}
+
+func (x *ExecutionConfiguration_Credentials) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *ExecutionConfiguration_Credentials) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *ExecutionConfiguration_Credentials) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
 type ExecutionConfiguration_FunctionParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -316,7 +651,7 @@ type ExecutionConfiguration_FunctionParameter struct {
 func (x *ExecutionConfiguration_FunctionParameter) Reset() {
 	*x = ExecutionConfiguration_FunctionParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_foreverbull_service_worker_proto_msgTypes[4]
+		mi := &file_foreverbull_service_worker_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -329,7 +664,7 @@ func (x *ExecutionConfiguration_FunctionParameter) String() string {
 func (*ExecutionConfiguration_FunctionParameter) ProtoMessage() {}
 
 func (x *ExecutionConfiguration_FunctionParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_foreverbull_service_worker_proto_msgTypes[4]
+	mi := &file_foreverbull_service_worker_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +677,7 @@ func (x *ExecutionConfiguration_FunctionParameter) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ExecutionConfiguration_FunctionParameter.ProtoReflect.Descriptor instead.
 func (*ExecutionConfiguration_FunctionParameter) Descriptor() ([]byte, []int) {
-	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{1, 0}
+	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{1, 1}
 }
 
 func (x *ExecutionConfiguration_FunctionParameter) GetKey() string {
@@ -371,7 +706,7 @@ type ExecutionConfiguration_Function struct {
 func (x *ExecutionConfiguration_Function) Reset() {
 	*x = ExecutionConfiguration_Function{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_foreverbull_service_worker_proto_msgTypes[5]
+		mi := &file_foreverbull_service_worker_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -384,7 +719,7 @@ func (x *ExecutionConfiguration_Function) String() string {
 func (*ExecutionConfiguration_Function) ProtoMessage() {}
 
 func (x *ExecutionConfiguration_Function) ProtoReflect() protoreflect.Message {
-	mi := &file_foreverbull_service_worker_proto_msgTypes[5]
+	mi := &file_foreverbull_service_worker_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +732,7 @@ func (x *ExecutionConfiguration_Function) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionConfiguration_Function.ProtoReflect.Descriptor instead.
 func (*ExecutionConfiguration_Function) Descriptor() ([]byte, []int) {
-	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{1, 1}
+	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{1, 2}
 }
 
 func (x *ExecutionConfiguration_Function) GetName() string {
@@ -414,75 +749,252 @@ func (x *ExecutionConfiguration_Function) GetParameters() []*ExecutionConfigurat
 	return nil
 }
 
+type Broadcast_Orders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*finance.Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *Broadcast_Orders) Reset() {
+	*x = Broadcast_Orders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_service_worker_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Broadcast_Orders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Broadcast_Orders) ProtoMessage() {}
+
+func (x *Broadcast_Orders) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_service_worker_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Broadcast_Orders.ProtoReflect.Descriptor instead.
+func (*Broadcast_Orders) Descriptor() ([]byte, []int) {
+	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Broadcast_Orders) GetOrders() []*finance.Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type Broadcast_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Message *string `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
+}
+
+func (x *Broadcast_Event) Reset() {
+	*x = Broadcast_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_service_worker_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Broadcast_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Broadcast_Event) ProtoMessage() {}
+
+func (x *Broadcast_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_service_worker_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Broadcast_Event.ProtoReflect.Descriptor instead.
+func (*Broadcast_Event) Descriptor() ([]byte, []int) {
+	return file_foreverbull_service_worker_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Broadcast_Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Broadcast_Event) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
 var File_foreverbull_service_worker_proto protoreflect.FileDescriptor
 
 var file_foreverbull_service_worker_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2f, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x13, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x8b, 0x04, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x45, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62,
-	0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x1a, 0xa2, 0x01, 0x0a, 0x11, 0x46, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2f, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x66, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x05, 0x0a, 0x09,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x6f, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x5e, 0x0a,
+	0x10, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x1a, 0xa2, 0x01,
+	0x0a, 0x11, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x1a, 0xf2, 0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
+	0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x75, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x1a, 0x67, 0x0a, 0x14, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x27, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xd4,
-	0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x50, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c,
-	0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x61,
-	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x75, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75,
-	0x6e, 0x4c, 0x61, 0x73, 0x74, 0x22, 0x90, 0x03, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x72,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x52, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x66, 0x6f,
-	0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3b, 0x0a, 0x11,
-	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x7d, 0x0a, 0x08, 0x46, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
-	0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x68, 0x6a, 0x6e, 0x69, 0x6c, 0x73, 0x73, 0x6f,
-	0x6e, 0x2f, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x12, 0x39, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xa5, 0x06, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x72, 0x6f, 0x6b, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x52, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75,
+	0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x5e, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x1a, 0x95, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x3b, 0x0a, 0x11, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x7d, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x66, 0x6f, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x52, 0x4c, 0x22, 0x95, 0x03, 0x0a, 0x09, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x3e, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c,
+	0x6c, 0x2e, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x12, 0x3f, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x3c, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x1a,
+	0x3c, 0x0a, 0x06, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6f, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x46, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2a, 0x38, 0x0a, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x27, 0x0a, 0x0f, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0a, 0x0a,
+	0x06, 0x4d, 0x41, 0x4e, 0x47, 0x4f, 0x53, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x52, 0x50,
+	0x43, 0x10, 0x01, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x68, 0x6a, 0x6e, 0x69, 0x6c, 0x73, 0x73, 0x6f, 0x6e, 0x2f, 0x66, 0x6f, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -497,25 +1009,45 @@ func file_foreverbull_service_worker_proto_rawDescGZIP() []byte {
 	return file_foreverbull_service_worker_proto_rawDescData
 }
 
-var file_foreverbull_service_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_foreverbull_service_worker_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_foreverbull_service_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_foreverbull_service_worker_proto_goTypes = []any{
-	(*Algorithm)(nil),                                // 0: foreverbull.service.Algorithm
-	(*ExecutionConfiguration)(nil),                   // 1: foreverbull.service.ExecutionConfiguration
-	(*Algorithm_FunctionParameter)(nil),              // 2: foreverbull.service.Algorithm.FunctionParameter
-	(*Algorithm_Function)(nil),                       // 3: foreverbull.service.Algorithm.Function
-	(*ExecutionConfiguration_FunctionParameter)(nil), // 4: foreverbull.service.ExecutionConfiguration.FunctionParameter
-	(*ExecutionConfiguration_Function)(nil),          // 5: foreverbull.service.ExecutionConfiguration.Function
+	(NamespaceScope)(0),                              // 0: foreverbull.service.NamespaceScope
+	(WorkerTransport)(0),                             // 1: foreverbull.service.WorkerTransport
+	(*Algorithm)(nil),                                // 2: foreverbull.service.Algorithm
+	(*ExecutionConfiguration)(nil),                   // 3: foreverbull.service.ExecutionConfiguration
+	(*Broadcast)(nil),                                // 4: foreverbull.service.Broadcast
+	(*Algorithm_FunctionParameter)(nil),              // 5: foreverbull.service.Algorithm.FunctionParameter
+	(*Algorithm_Function)(nil),                       // 6: foreverbull.service.Algorithm.Function
+	nil,                                              // 7: foreverbull.service.Algorithm.NamespaceScopesEntry
+	(*ExecutionConfiguration_Credentials)(nil),       // 8: foreverbull.service.ExecutionConfiguration.Credentials
+	(*ExecutionConfiguration_FunctionParameter)(nil), // 9: foreverbull.service.ExecutionConfiguration.FunctionParameter
+	(*ExecutionConfiguration_Function)(nil),          // 10: foreverbull.service.ExecutionConfiguration.Function
+	(*Broadcast_Orders)(nil),                         // 11: foreverbull.service.Broadcast.Orders
+	(*Broadcast_Event)(nil),                          // 12: foreverbull.service.Broadcast.Event
+	(*timestamppb.Timestamp)(nil),                    // 13: google.protobuf.Timestamp
+	(*finance.Portfolio)(nil),                        // 14: foreverbull.finance.Portfolio
+	(*finance.Order)(nil),                            // 15: foreverbull.finance.Order
 }
 var file_foreverbull_service_worker_proto_depIdxs = []int32{
-	3, // 0: foreverbull.service.Algorithm.functions:type_name -> foreverbull.service.Algorithm.Function
-	5, // 1: foreverbull.service.ExecutionConfiguration.functions:type_name -> foreverbull.service.ExecutionConfiguration.Function
-	2, // 2: foreverbull.service.Algorithm.Function.parameters:type_name -> foreverbull.service.Algorithm.FunctionParameter
-	4, // 3: foreverbull.service.ExecutionConfiguration.Function.parameters:type_name -> foreverbull.service.ExecutionConfiguration.FunctionParameter
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6,  // 0: foreverbull.service.Algorithm.functions:type_name -> foreverbull.service.Algorithm.Function
+	7,  // 1: foreverbull.service.Algorithm.namespace_scopes:type_name -> foreverbull.service.Algorithm.NamespaceScopesEntry
+	10, // 2: foreverbull.service.ExecutionConfiguration.functions:type_name -> foreverbull.service.ExecutionConfiguration.Function
+	1,  // 3: foreverbull.service.ExecutionConfiguration.transport:type_name -> foreverbull.service.WorkerTransport
+	8,  // 4: foreverbull.service.ExecutionConfiguration.credentials:type_name -> foreverbull.service.ExecutionConfiguration.Credentials
+	13, // 5: foreverbull.service.Broadcast.timestamp:type_name -> google.protobuf.Timestamp
+	14, // 6: foreverbull.service.Broadcast.portfolio:type_name -> foreverbull.finance.Portfolio
+	11, // 7: foreverbull.service.Broadcast.orders:type_name -> foreverbull.service.Broadcast.Orders
+	12, // 8: foreverbull.service.Broadcast.event:type_name -> foreverbull.service.Broadcast.Event
+	5,  // 9: foreverbull.service.Algorithm.Function.parameters:type_name -> foreverbull.service.Algorithm.FunctionParameter
+	0,  // 10: foreverbull.service.Algorithm.NamespaceScopesEntry.value:type_name -> foreverbull.service.NamespaceScope
+	9,  // 11: foreverbull.service.ExecutionConfiguration.Function.parameters:type_name -> foreverbull.service.ExecutionConfiguration.FunctionParameter
+	15, // 12: foreverbull.service.Broadcast.Orders.orders:type_name -> foreverbull.finance.Order
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_foreverbull_service_worker_proto_init() }
@@ -549,7 +1081,7 @@ func file_foreverbull_service_worker_proto_init() {
 			}
 		}
 		file_foreverbull_service_worker_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Algorithm_FunctionParameter); i {
+			switch v := v.(*Broadcast); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -561,7 +1093,7 @@ func file_foreverbull_service_worker_proto_init() {
 			}
 		}
 		file_foreverbull_service_worker_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*Algorithm_Function); i {
+			switch v := v.(*Algorithm_FunctionParameter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -573,6 +1105,30 @@ func file_foreverbull_service_worker_proto_init() {
 			}
 		}
 		file_foreverbull_service_worker_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Algorithm_Function); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_service_worker_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ExecutionConfiguration_Credentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_service_worker_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*ExecutionConfiguration_FunctionParameter); i {
 			case 0:
 				return &v.state
@@ -584,7 +1140,7 @@ func file_foreverbull_service_worker_proto_init() {
 				return nil
 			}
 		}
-		file_foreverbull_service_worker_proto_msgTypes[5].Exporter = func(v any, i int) any {
+		file_foreverbull_service_worker_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*ExecutionConfiguration_Function); i {
 			case 0:
 				return &v.state
@@ -596,20 +1152,52 @@ func file_foreverbull_service_worker_proto_init() {
 				return nil
 			}
 		}
+		file_foreverbull_service_worker_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Broadcast_Orders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_service_worker_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Broadcast_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_foreverbull_service_worker_proto_msgTypes[1].OneofWrappers = []any{}
+	file_foreverbull_service_worker_proto_msgTypes[2].OneofWrappers = []any{
+		(*Broadcast_Portfolio)(nil),
+		(*Broadcast_Orders_)(nil),
+		(*Broadcast_Event_)(nil),
 	}
-	file_foreverbull_service_worker_proto_msgTypes[2].OneofWrappers = []any{}
+	file_foreverbull_service_worker_proto_msgTypes[3].OneofWrappers = []any{}
+	file_foreverbull_service_worker_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_foreverbull_service_worker_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_foreverbull_service_worker_proto_goTypes,
 		DependencyIndexes: file_foreverbull_service_worker_proto_depIdxs,
+		EnumInfos:         file_foreverbull_service_worker_proto_enumTypes,
 		MessageInfos:      file_foreverbull_service_worker_proto_msgTypes,
 	}.Build()
 	File_foreverbull_service_worker_proto = out.File
@@ -28,6 +28,16 @@ type NamespaceRequestType int32
 const (
 	NamespaceRequestType_GET NamespaceRequestType = 0
 	NamespaceRequestType_SET NamespaceRequestType = 1
+	// Adds the numeric fields of value to the stored fields
+	NamespaceRequestType_INCREMENT NamespaceRequestType = 2
+	// Appends the fields of value to the stored lists
+	NamespaceRequestType_APPEND NamespaceRequestType = 3
+	// Removes fields from the namespace
+	NamespaceRequestType_DELETE NamespaceRequestType = 4
+	// Sets value only when the stored fields match expected
+	NamespaceRequestType_COMPARE_AND_SET NamespaceRequestType = 5
+	// Reads all namespaces in keys at once
+	NamespaceRequestType_GET_MANY NamespaceRequestType = 6
 )
 
 // Enum value maps for NamespaceRequestType.
@@ -35,10 +45,20 @@ var (
 	NamespaceRequestType_name = map[int32]string{
 		0: "GET",
 		1: "SET",
+		2: "INCREMENT",
+		3: "APPEND",
+		4: "DELETE",
+		5: "COMPARE_AND_SET",
+		6: "GET_MANY",
 	}
 	NamespaceRequestType_value = map[string]int32{
-		"GET": 0,
-		"SET": 1,
+		"GET":             0,
+		"SET":             1,
+		"INCREMENT":       2,
+		"APPEND":          3,
+		"DELETE":          4,
+		"COMPARE_AND_SET": 5,
+		"GET_MANY":        6,
 	}
 )
 
@@ -446,9 +466,12 @@ type NamespaceRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Type  NamespaceRequestType `protobuf:"varint,2,opt,name=type,proto3,enum=foreverbull.service.NamespaceRequestType" json:"type,omitempty"`
-	Value *structpb.Struct     `protobuf:"bytes,3,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Key      string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type     NamespaceRequestType `protobuf:"varint,2,opt,name=type,proto3,enum=foreverbull.service.NamespaceRequestType" json:"type,omitempty"`
+	Value    *structpb.Struct     `protobuf:"bytes,3,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Fields   []string             `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Expected *structpb.Struct     `protobuf:"bytes,5,opt,name=expected,proto3,oneof" json:"expected,omitempty"`
+	Keys     []string             `protobuf:"bytes,6,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
 func (x *NamespaceRequest) Reset() {
@@ -504,13 +527,36 @@ func (x *NamespaceRequest) GetValue() *structpb.Struct {
 	return nil
 }
 
+func (x *NamespaceRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *NamespaceRequest) GetExpected() *structpb.Struct {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *NamespaceRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 type NamespaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *structpb.Struct `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
-	Error *string          `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Value   *structpb.Struct            `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Error   *string                     `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Swapped bool                        `protobuf:"varint,3,opt,name=swapped,proto3" json:"swapped,omitempty"`
+	Values  map[string]*structpb.Struct `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *NamespaceResponse) Reset() {
@@ -559,6 +605,20 @@ func (x *NamespaceResponse) GetError() string {
 	return ""
 }
 
+func (x *NamespaceResponse) GetSwapped() bool {
+	if x != nil {
+		return x.Swapped
+	}
+	return false
+}
+
+func (x *NamespaceResponse) GetValues() map[string]*structpb.Struct {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 var File_foreverbull_service_worker_service_proto protoreflect.FileDescriptor
 
 var file_foreverbull_service_worker_service_proto_rawDesc = []byte{
@@ -610,7 +670,7 @@ var file_foreverbull_service_worker_service_proto_rawDesc = []byte{
 	0x6c, 0x6c, 0x2e, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
 	0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa1, 0x01,
+	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x94, 0x02,
 	0x0a, 0x10, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -620,43 +680,79 @@ var file_foreverbull_service_worker_service_proto_rawDesc = []byte{
 	0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x76, 0x0a, 0x11, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x28, 0x0a, 0x14, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45,
-	0x54, 0x10, 0x01, 0x32, 0xd5, 0x02, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x6b,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x2a, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x66,
+	0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x38, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x01, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x22, 0xb0, 0x02, 0x0a, 0x11, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x77, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c,
+	0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a,
+	0x52, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x72, 0x0a, 0x14, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45, 0x54, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4d, 0x50,
+	0x41, 0x52, 0x45, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x05, 0x12, 0x0c, 0x0a,
+	0x08, 0x47, 0x45, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x10, 0x06, 0x32, 0xd5, 0x02, 0x0a, 0x06,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75,
+	0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0c,
+	0x52, 0x75, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x66,
 	0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x12, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75,
-	0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x68, 0x6a, 0x6e, 0x69, 0x6c,
-	0x73, 0x73, 0x6f, 0x6e, 0x2f, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x32, 0xc6, 0x01, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x42, 0x72,
+	0x6f, 0x6b, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12,
+	0x23, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x22, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75,
+	0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5c,
+	0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x66, 0x6f,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x68, 0x6a, 0x6e, 0x69,
+	0x6c, 0x73, 0x73, 0x6f, 0x6e, 0x2f, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c,
+	0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -672,7 +768,7 @@ func file_foreverbull_service_worker_service_proto_rawDescGZIP() []byte {
 }
 
 var file_foreverbull_service_worker_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_foreverbull_service_worker_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_foreverbull_service_worker_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_foreverbull_service_worker_service_proto_goTypes = []any{
 	(NamespaceRequestType)(0),          // 0: foreverbull.service.NamespaceRequestType
 	(*GetServiceInfoRequest)(nil),      // 1: foreverbull.service.GetServiceInfoRequest
@@ -685,31 +781,39 @@ var file_foreverbull_service_worker_service_proto_goTypes = []any{
 	(*WorkerResponse)(nil),             // 8: foreverbull.service.WorkerResponse
 	(*NamespaceRequest)(nil),           // 9: foreverbull.service.NamespaceRequest
 	(*NamespaceResponse)(nil),          // 10: foreverbull.service.NamespaceResponse
-	(*Algorithm)(nil),                  // 11: foreverbull.service.Algorithm
-	(*ExecutionConfiguration)(nil),     // 12: foreverbull.service.ExecutionConfiguration
-	(*finance.Portfolio)(nil),          // 13: foreverbull.finance.Portfolio
-	(*finance.Order)(nil),              // 14: foreverbull.finance.Order
-	(*structpb.Struct)(nil),            // 15: google.protobuf.Struct
+	nil,                                // 11: foreverbull.service.NamespaceResponse.ValuesEntry
+	(*Algorithm)(nil),                  // 12: foreverbull.service.Algorithm
+	(*ExecutionConfiguration)(nil),     // 13: foreverbull.service.ExecutionConfiguration
+	(*finance.Portfolio)(nil),          // 14: foreverbull.finance.Portfolio
+	(*finance.Order)(nil),              // 15: foreverbull.finance.Order
+	(*structpb.Struct)(nil),            // 16: google.protobuf.Struct
 }
 var file_foreverbull_service_worker_service_proto_depIdxs = []int32{
-	11, // 0: foreverbull.service.GetServiceInfoResponse.algorithm:type_name -> foreverbull.service.Algorithm
-	12, // 1: foreverbull.service.ConfigureExecutionRequest.configuration:type_name -> foreverbull.service.ExecutionConfiguration
-	13, // 2: foreverbull.service.WorkerRequest.portfolio:type_name -> foreverbull.finance.Portfolio
-	14, // 3: foreverbull.service.WorkerResponse.orders:type_name -> foreverbull.finance.Order
+	12, // 0: foreverbull.service.GetServiceInfoResponse.algorithm:type_name -> foreverbull.service.Algorithm
+	13, // 1: foreverbull.service.ConfigureExecutionRequest.configuration:type_name -> foreverbull.service.ExecutionConfiguration
+	14, // 2: foreverbull.service.WorkerRequest.portfolio:type_name -> foreverbull.finance.Portfolio
+	15, // 3: foreverbull.service.WorkerResponse.orders:type_name -> foreverbull.finance.Order
 	0,  // 4: foreverbull.service.NamespaceRequest.type:type_name -> foreverbull.service.NamespaceRequestType
-	15, // 5: foreverbull.service.NamespaceRequest.value:type_name -> google.protobuf.Struct
-	15, // 6: foreverbull.service.NamespaceResponse.value:type_name -> google.protobuf.Struct
-	1,  // 7: foreverbull.service.Worker.GetServiceInfo:input_type -> foreverbull.service.GetServiceInfoRequest
-	3,  // 8: foreverbull.service.Worker.ConfigureExecution:input_type -> foreverbull.service.ConfigureExecutionRequest
-	5,  // 9: foreverbull.service.Worker.RunExecution:input_type -> foreverbull.service.RunExecutionRequest
-	2,  // 10: foreverbull.service.Worker.GetServiceInfo:output_type -> foreverbull.service.GetServiceInfoResponse
-	4,  // 11: foreverbull.service.Worker.ConfigureExecution:output_type -> foreverbull.service.ConfigureExecutionResponse
-	6,  // 12: foreverbull.service.Worker.RunExecution:output_type -> foreverbull.service.RunExecutionResponse
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	16, // 5: foreverbull.service.NamespaceRequest.value:type_name -> google.protobuf.Struct
+	16, // 6: foreverbull.service.NamespaceRequest.expected:type_name -> google.protobuf.Struct
+	16, // 7: foreverbull.service.NamespaceResponse.value:type_name -> google.protobuf.Struct
+	11, // 8: foreverbull.service.NamespaceResponse.values:type_name -> foreverbull.service.NamespaceResponse.ValuesEntry
+	16, // 9: foreverbull.service.NamespaceResponse.ValuesEntry.value:type_name -> google.protobuf.Struct
+	1,  // 10: foreverbull.service.Worker.GetServiceInfo:input_type -> foreverbull.service.GetServiceInfoRequest
+	3,  // 11: foreverbull.service.Worker.ConfigureExecution:input_type -> foreverbull.service.ConfigureExecutionRequest
+	5,  // 12: foreverbull.service.Worker.RunExecution:input_type -> foreverbull.service.RunExecutionRequest
+	8,  // 13: foreverbull.service.WorkerBroker.Connect:input_type -> foreverbull.service.WorkerResponse
+	9,  // 14: foreverbull.service.WorkerBroker.Namespace:input_type -> foreverbull.service.NamespaceRequest
+	2,  // 15: foreverbull.service.Worker.GetServiceInfo:output_type -> foreverbull.service.GetServiceInfoResponse
+	4,  // 16: foreverbull.service.Worker.ConfigureExecution:output_type -> foreverbull.service.ConfigureExecutionResponse
+	6,  // 17: foreverbull.service.Worker.RunExecution:output_type -> foreverbull.service.RunExecutionResponse
+	7,  // 18: foreverbull.service.WorkerBroker.Connect:output_type -> foreverbull.service.WorkerRequest
+	10, // 19: foreverbull.service.WorkerBroker.Namespace:output_type -> foreverbull.service.NamespaceResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_foreverbull_service_worker_service_proto_init() }
@@ -849,9 +953,9 @@ func file_foreverbull_service_worker_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_foreverbull_service_worker_service_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_foreverbull_service_worker_service_proto_goTypes,
 		DependencyIndexes: file_foreverbull_service_worker_service_proto_depIdxs,
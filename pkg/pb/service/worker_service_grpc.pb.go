@@ -195,3 +195,148 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "foreverbull/service/worker_service.proto",
 }
+
+const (
+	WorkerBroker_Connect_FullMethodName   = "/foreverbull.service.WorkerBroker/Connect"
+	WorkerBroker_Namespace_FullMethodName = "/foreverbull.service.WorkerBroker/Namespace"
+)
+
+// WorkerBrokerClient is the client API for WorkerBroker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WorkerBroker is the GRPC worker transport, calls select their pool with the
+// foreverbull-pool metadata key.
+type WorkerBrokerClient interface {
+	// Connect streams requests to the worker, the worker answers every request with
+	// a response before it gets the next one.
+	Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[WorkerResponse, WorkerRequest], error)
+	Namespace(ctx context.Context, in *NamespaceRequest, opts ...grpc.CallOption) (*NamespaceResponse, error)
+}
+
+type workerBrokerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkerBrokerClient(cc grpc.ClientConnInterface) WorkerBrokerClient {
+	return &workerBrokerClient{cc}
+}
+
+func (c *workerBrokerClient) Connect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[WorkerResponse, WorkerRequest], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WorkerBroker_ServiceDesc.Streams[0], WorkerBroker_Connect_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WorkerResponse, WorkerRequest]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkerBroker_ConnectClient = grpc.BidiStreamingClient[WorkerResponse, WorkerRequest]
+
+func (c *workerBrokerClient) Namespace(ctx context.Context, in *NamespaceRequest, opts ...grpc.CallOption) (*NamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceResponse)
+	err := c.cc.Invoke(ctx, WorkerBroker_Namespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkerBrokerServer is the server API for WorkerBroker service.
+// All implementations must embed UnimplementedWorkerBrokerServer
+// for forward compatibility.
+//
+// WorkerBroker is the GRPC worker transport, calls select their pool with the
+// foreverbull-pool metadata key.
+type WorkerBrokerServer interface {
+	// Connect streams requests to the worker, the worker answers every request with
+	// a response before it gets the next one.
+	Connect(grpc.BidiStreamingServer[WorkerResponse, WorkerRequest]) error
+	Namespace(context.Context, *NamespaceRequest) (*NamespaceResponse, error)
+	mustEmbedUnimplementedWorkerBrokerServer()
+}
+
+// UnimplementedWorkerBrokerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorkerBrokerServer struct{}
+
+func (UnimplementedWorkerBrokerServer) Connect(grpc.BidiStreamingServer[WorkerResponse, WorkerRequest]) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedWorkerBrokerServer) Namespace(context.Context, *NamespaceRequest) (*NamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Namespace not implemented")
+}
+func (UnimplementedWorkerBrokerServer) mustEmbedUnimplementedWorkerBrokerServer() {}
+func (UnimplementedWorkerBrokerServer) testEmbeddedByValue()                      {}
+
+// UnsafeWorkerBrokerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkerBrokerServer will
+// result in compilation errors.
+type UnsafeWorkerBrokerServer interface {
+	mustEmbedUnimplementedWorkerBrokerServer()
+}
+
+func RegisterWorkerBrokerServer(s grpc.ServiceRegistrar, srv WorkerBrokerServer) {
+	// If the following call pancis, it indicates UnimplementedWorkerBrokerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorkerBroker_ServiceDesc, srv)
+}
+
+func _WorkerBroker_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerBrokerServer).Connect(&grpc.GenericServerStream[WorkerResponse, WorkerRequest]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WorkerBroker_ConnectServer = grpc.BidiStreamingServer[WorkerResponse, WorkerRequest]
+
+func _WorkerBroker_Namespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerBrokerServer).Namespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerBroker_Namespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerBrokerServer).Namespace(ctx, req.(*NamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkerBroker_ServiceDesc is the grpc.ServiceDesc for WorkerBroker service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkerBroker_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "foreverbull.service.WorkerBroker",
+	HandlerType: (*WorkerBrokerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Namespace",
+			Handler:    _WorkerBroker_Namespace_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _WorkerBroker_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "foreverbull/service/worker_service.proto",
+}
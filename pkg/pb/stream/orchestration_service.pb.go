@@ -0,0 +1,978 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: foreverbull/stream/orchestration_service.proto
+
+package stream
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListOrchestrationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+}
+
+func (x *ListOrchestrationsRequest) Reset() {
+	*x = ListOrchestrationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrchestrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrchestrationsRequest) ProtoMessage() {}
+
+func (x *ListOrchestrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrchestrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrchestrationsRequest) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListOrchestrationsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ListOrchestrationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orchestrations []*Orchestration `protobuf:"bytes,1,rep,name=orchestrations,proto3" json:"orchestrations,omitempty"`
+}
+
+func (x *ListOrchestrationsResponse) Reset() {
+	*x = ListOrchestrationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrchestrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrchestrationsResponse) ProtoMessage() {}
+
+func (x *ListOrchestrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrchestrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrchestrationsResponse) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListOrchestrationsResponse) GetOrchestrations() []*Orchestration {
+	if x != nil {
+		return x.Orchestrations
+	}
+	return nil
+}
+
+type GetOrchestrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrchestrationId string `protobuf:"bytes,1,opt,name=orchestration_id,json=orchestrationId,proto3" json:"orchestration_id,omitempty"`
+}
+
+func (x *GetOrchestrationRequest) Reset() {
+	*x = GetOrchestrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrchestrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrchestrationRequest) ProtoMessage() {}
+
+func (x *GetOrchestrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrchestrationRequest.ProtoReflect.Descriptor instead.
+func (*GetOrchestrationRequest) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetOrchestrationRequest) GetOrchestrationId() string {
+	if x != nil {
+		return x.OrchestrationId
+	}
+	return ""
+}
+
+type GetOrchestrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orchestration *Orchestration `protobuf:"bytes,1,opt,name=orchestration,proto3" json:"orchestration,omitempty"`
+}
+
+func (x *GetOrchestrationResponse) Reset() {
+	*x = GetOrchestrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrchestrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrchestrationResponse) ProtoMessage() {}
+
+func (x *GetOrchestrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrchestrationResponse.ProtoReflect.Descriptor instead.
+func (*GetOrchestrationResponse) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOrchestrationResponse) GetOrchestration() *Orchestration {
+	if x != nil {
+		return x.Orchestration
+	}
+	return nil
+}
+
+type WatchOrchestrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrchestrationId string `protobuf:"bytes,1,opt,name=orchestration_id,json=orchestrationId,proto3" json:"orchestration_id,omitempty"`
+}
+
+func (x *WatchOrchestrationRequest) Reset() {
+	*x = WatchOrchestrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOrchestrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrchestrationRequest) ProtoMessage() {}
+
+func (x *WatchOrchestrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrchestrationRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrchestrationRequest) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchOrchestrationRequest) GetOrchestrationId() string {
+	if x != nil {
+		return x.OrchestrationId
+	}
+	return ""
+}
+
+type WatchOrchestrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orchestration *Orchestration `protobuf:"bytes,1,opt,name=orchestration,proto3" json:"orchestration,omitempty"`
+	Command       *Command       `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *WatchOrchestrationResponse) Reset() {
+	*x = WatchOrchestrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOrchestrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrchestrationResponse) ProtoMessage() {}
+
+func (x *WatchOrchestrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrchestrationResponse.ProtoReflect.Descriptor instead.
+func (*WatchOrchestrationResponse) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchOrchestrationResponse) GetOrchestration() *Orchestration {
+	if x != nil {
+		return x.Orchestration
+	}
+	return nil
+}
+
+func (x *WatchOrchestrationResponse) GetCommand() *Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type ResumeOrchestrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrchestrationId string `protobuf:"bytes,1,opt,name=orchestration_id,json=orchestrationId,proto3" json:"orchestration_id,omitempty"`
+}
+
+func (x *ResumeOrchestrationRequest) Reset() {
+	*x = ResumeOrchestrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeOrchestrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeOrchestrationRequest) ProtoMessage() {}
+
+func (x *ResumeOrchestrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeOrchestrationRequest.ProtoReflect.Descriptor instead.
+func (*ResumeOrchestrationRequest) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResumeOrchestrationRequest) GetOrchestrationId() string {
+	if x != nil {
+		return x.OrchestrationId
+	}
+	return ""
+}
+
+type ResumeOrchestrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orchestration *Orchestration `protobuf:"bytes,1,opt,name=orchestration,proto3" json:"orchestration,omitempty"`
+}
+
+func (x *ResumeOrchestrationResponse) Reset() {
+	*x = ResumeOrchestrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeOrchestrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeOrchestrationResponse) ProtoMessage() {}
+
+func (x *ResumeOrchestrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeOrchestrationResponse.ProtoReflect.Descriptor instead.
+func (*ResumeOrchestrationResponse) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResumeOrchestrationResponse) GetOrchestration() *Orchestration {
+	if x != nil {
+		return x.Orchestration
+	}
+	return nil
+}
+
+type RetryOrchestrationStepRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrchestrationId string `protobuf:"bytes,1,opt,name=orchestration_id,json=orchestrationId,proto3" json:"orchestration_id,omitempty"`
+	Step            string `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"`
+}
+
+func (x *RetryOrchestrationStepRequest) Reset() {
+	*x = RetryOrchestrationStepRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryOrchestrationStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryOrchestrationStepRequest) ProtoMessage() {}
+
+func (x *RetryOrchestrationStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryOrchestrationStepRequest.ProtoReflect.Descriptor instead.
+func (*RetryOrchestrationStepRequest) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RetryOrchestrationStepRequest) GetOrchestrationId() string {
+	if x != nil {
+		return x.OrchestrationId
+	}
+	return ""
+}
+
+func (x *RetryOrchestrationStepRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+type RetryOrchestrationStepResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orchestration *Orchestration `protobuf:"bytes,1,opt,name=orchestration,proto3" json:"orchestration,omitempty"`
+}
+
+func (x *RetryOrchestrationStepResponse) Reset() {
+	*x = RetryOrchestrationStepResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryOrchestrationStepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryOrchestrationStepResponse) ProtoMessage() {}
+
+func (x *RetryOrchestrationStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryOrchestrationStepResponse.ProtoReflect.Descriptor instead.
+func (*RetryOrchestrationStepResponse) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RetryOrchestrationStepResponse) GetOrchestration() *Orchestration {
+	if x != nil {
+		return x.Orchestration
+	}
+	return nil
+}
+
+type CancelOrchestrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrchestrationId string `protobuf:"bytes,1,opt,name=orchestration_id,json=orchestrationId,proto3" json:"orchestration_id,omitempty"`
+}
+
+func (x *CancelOrchestrationRequest) Reset() {
+	*x = CancelOrchestrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrchestrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrchestrationRequest) ProtoMessage() {}
+
+func (x *CancelOrchestrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrchestrationRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrchestrationRequest) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelOrchestrationRequest) GetOrchestrationId() string {
+	if x != nil {
+		return x.OrchestrationId
+	}
+	return ""
+}
+
+type CancelOrchestrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orchestration *Orchestration `protobuf:"bytes,1,opt,name=orchestration,proto3" json:"orchestration,omitempty"`
+}
+
+func (x *CancelOrchestrationResponse) Reset() {
+	*x = CancelOrchestrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrchestrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrchestrationResponse) ProtoMessage() {}
+
+func (x *CancelOrchestrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_foreverbull_stream_orchestration_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrchestrationResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrchestrationResponse) Descriptor() ([]byte, []int) {
+	return file_foreverbull_stream_orchestration_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelOrchestrationResponse) GetOrchestration() *Orchestration {
+	if x != nil {
+		return x.Orchestration
+	}
+	return nil
+}
+
+var File_foreverbull_stream_orchestration_service_proto protoreflect.FileDescriptor
+
+var file_foreverbull_stream_orchestration_service_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x1a, 0x26, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c,
+	0x6c, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75,
+	0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3d, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x65, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x10,
+	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba, 0x48, 0x1c, 0xba, 0x01, 0x16, 0x0a, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21,
+	0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01, 0x01, 0x52, 0x0f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x6f,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a,
+	0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x10, 0x6f, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba, 0x48, 0x1c, 0xba, 0x01, 0x16, 0x0a, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20,
+	0x27, 0x27, 0xc8, 0x01, 0x01, 0x52, 0x0f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66,
+	0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x68, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4f,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x10, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba,
+	0x48, 0x1c, 0xba, 0x01, 0x16, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x1a,
+	0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01, 0x01, 0x52, 0x0f,
+	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x66, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0d, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62,
+	0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x10, 0x6f, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1f, 0xba, 0x48, 0x1c, 0xba, 0x01, 0x16, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27,
+	0x27, 0xc8, 0x01, 0x01, 0x52, 0x0f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1f, 0xba, 0x48, 0x1c, 0xba, 0x01, 0x16, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27,
+	0x27, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x69, 0x0a, 0x1e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0d,
+	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c,
+	0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x10, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba,
+	0x48, 0x1c, 0xba, 0x01, 0x16, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x1a,
+	0x0a, 0x74, 0x68, 0x69, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0xc8, 0x01, 0x01, 0x52, 0x0f,
+	0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x66, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0d, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62,
+	0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x4f, 0x72, 0x63, 0x68, 0x65,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xe3, 0x05, 0x0a, 0x15, 0x4f, 0x72, 0x63, 0x68,
+	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x72, 0x12, 0x73, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x66, 0x6f, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x66, 0x6f,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x66, 0x6f, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x76, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c,
+	0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4f,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c,
+	0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4f,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x16, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x31,
+	0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x72, 0x63, 0x68,
+	0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x66,
+	0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66,
+	0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75, 0x6c, 0x6c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x68, 0x6a, 0x6e,
+	0x69, 0x6c, 0x73, 0x73, 0x6f, 0x6e, 0x2f, 0x66, 0x6f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x62, 0x75,
+	0x6c, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_foreverbull_stream_orchestration_service_proto_rawDescOnce sync.Once
+	file_foreverbull_stream_orchestration_service_proto_rawDescData = file_foreverbull_stream_orchestration_service_proto_rawDesc
+)
+
+func file_foreverbull_stream_orchestration_service_proto_rawDescGZIP() []byte {
+	file_foreverbull_stream_orchestration_service_proto_rawDescOnce.Do(func() {
+		file_foreverbull_stream_orchestration_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_foreverbull_stream_orchestration_service_proto_rawDescData)
+	})
+	return file_foreverbull_stream_orchestration_service_proto_rawDescData
+}
+
+var file_foreverbull_stream_orchestration_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_foreverbull_stream_orchestration_service_proto_goTypes = []any{
+	(*ListOrchestrationsRequest)(nil),      // 0: foreverbull.stream.ListOrchestrationsRequest
+	(*ListOrchestrationsResponse)(nil),     // 1: foreverbull.stream.ListOrchestrationsResponse
+	(*GetOrchestrationRequest)(nil),        // 2: foreverbull.stream.GetOrchestrationRequest
+	(*GetOrchestrationResponse)(nil),       // 3: foreverbull.stream.GetOrchestrationResponse
+	(*WatchOrchestrationRequest)(nil),      // 4: foreverbull.stream.WatchOrchestrationRequest
+	(*WatchOrchestrationResponse)(nil),     // 5: foreverbull.stream.WatchOrchestrationResponse
+	(*ResumeOrchestrationRequest)(nil),     // 6: foreverbull.stream.ResumeOrchestrationRequest
+	(*ResumeOrchestrationResponse)(nil),    // 7: foreverbull.stream.ResumeOrchestrationResponse
+	(*RetryOrchestrationStepRequest)(nil),  // 8: foreverbull.stream.RetryOrchestrationStepRequest
+	(*RetryOrchestrationStepResponse)(nil), // 9: foreverbull.stream.RetryOrchestrationStepResponse
+	(*CancelOrchestrationRequest)(nil),     // 10: foreverbull.stream.CancelOrchestrationRequest
+	(*CancelOrchestrationResponse)(nil),    // 11: foreverbull.stream.CancelOrchestrationResponse
+	(*Orchestration)(nil),                  // 12: foreverbull.stream.Orchestration
+	(*Command)(nil),                        // 13: foreverbull.stream.Command
+}
+var file_foreverbull_stream_orchestration_service_proto_depIdxs = []int32{
+	12, // 0: foreverbull.stream.ListOrchestrationsResponse.orchestrations:type_name -> foreverbull.stream.Orchestration
+	12, // 1: foreverbull.stream.GetOrchestrationResponse.orchestration:type_name -> foreverbull.stream.Orchestration
+	12, // 2: foreverbull.stream.WatchOrchestrationResponse.orchestration:type_name -> foreverbull.stream.Orchestration
+	13, // 3: foreverbull.stream.WatchOrchestrationResponse.command:type_name -> foreverbull.stream.Command
+	12, // 4: foreverbull.stream.ResumeOrchestrationResponse.orchestration:type_name -> foreverbull.stream.Orchestration
+	12, // 5: foreverbull.stream.RetryOrchestrationStepResponse.orchestration:type_name -> foreverbull.stream.Orchestration
+	12, // 6: foreverbull.stream.CancelOrchestrationResponse.orchestration:type_name -> foreverbull.stream.Orchestration
+	0,  // 7: foreverbull.stream.OrchestrationServicer.ListOrchestrations:input_type -> foreverbull.stream.ListOrchestrationsRequest
+	2,  // 8: foreverbull.stream.OrchestrationServicer.GetOrchestration:input_type -> foreverbull.stream.GetOrchestrationRequest
+	4,  // 9: foreverbull.stream.OrchestrationServicer.WatchOrchestration:input_type -> foreverbull.stream.WatchOrchestrationRequest
+	6,  // 10: foreverbull.stream.OrchestrationServicer.ResumeOrchestration:input_type -> foreverbull.stream.ResumeOrchestrationRequest
+	8,  // 11: foreverbull.stream.OrchestrationServicer.RetryOrchestrationStep:input_type -> foreverbull.stream.RetryOrchestrationStepRequest
+	10, // 12: foreverbull.stream.OrchestrationServicer.CancelOrchestration:input_type -> foreverbull.stream.CancelOrchestrationRequest
+	1,  // 13: foreverbull.stream.OrchestrationServicer.ListOrchestrations:output_type -> foreverbull.stream.ListOrchestrationsResponse
+	3,  // 14: foreverbull.stream.OrchestrationServicer.GetOrchestration:output_type -> foreverbull.stream.GetOrchestrationResponse
+	5,  // 15: foreverbull.stream.OrchestrationServicer.WatchOrchestration:output_type -> foreverbull.stream.WatchOrchestrationResponse
+	7,  // 16: foreverbull.stream.OrchestrationServicer.ResumeOrchestration:output_type -> foreverbull.stream.ResumeOrchestrationResponse
+	9,  // 17: foreverbull.stream.OrchestrationServicer.RetryOrchestrationStep:output_type -> foreverbull.stream.RetryOrchestrationStepResponse
+	11, // 18: foreverbull.stream.OrchestrationServicer.CancelOrchestration:output_type -> foreverbull.stream.CancelOrchestrationResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_foreverbull_stream_orchestration_service_proto_init() }
+func file_foreverbull_stream_orchestration_service_proto_init() {
+	if File_foreverbull_stream_orchestration_service_proto != nil {
+		return
+	}
+	file_foreverbull_stream_orchestration_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOrchestrationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOrchestrationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOrchestrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOrchestrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchOrchestrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchOrchestrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ResumeOrchestrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ResumeOrchestrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RetryOrchestrationStepRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RetryOrchestrationStepResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelOrchestrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foreverbull_stream_orchestration_service_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelOrchestrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_foreverbull_stream_orchestration_service_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_foreverbull_stream_orchestration_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_foreverbull_stream_orchestration_service_proto_goTypes,
+		DependencyIndexes: file_foreverbull_stream_orchestration_service_proto_depIdxs,
+		MessageInfos:      file_foreverbull_stream_orchestration_service_proto_msgTypes,
+	}.Build()
+	File_foreverbull_stream_orchestration_service_proto = out.File
+	file_foreverbull_stream_orchestration_service_proto_rawDesc = nil
+	file_foreverbull_stream_orchestration_service_proto_goTypes = nil
+	file_foreverbull_stream_orchestration_service_proto_depIdxs = nil
+}
@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: foreverbull/stream/orchestration_service.proto
+
+package stream
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OrchestrationServicer_ListOrchestrations_FullMethodName     = "/foreverbull.stream.OrchestrationServicer/ListOrchestrations"
+	OrchestrationServicer_GetOrchestration_FullMethodName       = "/foreverbull.stream.OrchestrationServicer/GetOrchestration"
+	OrchestrationServicer_WatchOrchestration_FullMethodName     = "/foreverbull.stream.OrchestrationServicer/WatchOrchestration"
+	OrchestrationServicer_ResumeOrchestration_FullMethodName    = "/foreverbull.stream.OrchestrationServicer/ResumeOrchestration"
+	OrchestrationServicer_RetryOrchestrationStep_FullMethodName = "/foreverbull.stream.OrchestrationServicer/RetryOrchestrationStep"
+	OrchestrationServicer_CancelOrchestration_FullMethodName    = "/foreverbull.stream.OrchestrationServicer/CancelOrchestration"
+)
+
+// OrchestrationServicerClient is the client API for OrchestrationServicer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrchestrationServicerClient interface {
+	ListOrchestrations(ctx context.Context, in *ListOrchestrationsRequest, opts ...grpc.CallOption) (*ListOrchestrationsResponse, error)
+	GetOrchestration(ctx context.Context, in *GetOrchestrationRequest, opts ...grpc.CallOption) (*GetOrchestrationResponse, error)
+	WatchOrchestration(ctx context.Context, in *WatchOrchestrationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchOrchestrationResponse], error)
+	ResumeOrchestration(ctx context.Context, in *ResumeOrchestrationRequest, opts ...grpc.CallOption) (*ResumeOrchestrationResponse, error)
+	RetryOrchestrationStep(ctx context.Context, in *RetryOrchestrationStepRequest, opts ...grpc.CallOption) (*RetryOrchestrationStepResponse, error)
+	CancelOrchestration(ctx context.Context, in *CancelOrchestrationRequest, opts ...grpc.CallOption) (*CancelOrchestrationResponse, error)
+}
+
+type orchestrationServicerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrchestrationServicerClient(cc grpc.ClientConnInterface) OrchestrationServicerClient {
+	return &orchestrationServicerClient{cc}
+}
+
+func (c *orchestrationServicerClient) ListOrchestrations(ctx context.Context, in *ListOrchestrationsRequest, opts ...grpc.CallOption) (*ListOrchestrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrchestrationsResponse)
+	err := c.cc.Invoke(ctx, OrchestrationServicer_ListOrchestrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestrationServicerClient) GetOrchestration(ctx context.Context, in *GetOrchestrationRequest, opts ...grpc.CallOption) (*GetOrchestrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrchestrationResponse)
+	err := c.cc.Invoke(ctx, OrchestrationServicer_GetOrchestration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestrationServicerClient) WatchOrchestration(ctx context.Context, in *WatchOrchestrationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchOrchestrationResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrchestrationServicer_ServiceDesc.Streams[0], OrchestrationServicer_WatchOrchestration_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOrchestrationRequest, WatchOrchestrationResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrchestrationServicer_WatchOrchestrationClient = grpc.ServerStreamingClient[WatchOrchestrationResponse]
+
+func (c *orchestrationServicerClient) ResumeOrchestration(ctx context.Context, in *ResumeOrchestrationRequest, opts ...grpc.CallOption) (*ResumeOrchestrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeOrchestrationResponse)
+	err := c.cc.Invoke(ctx, OrchestrationServicer_ResumeOrchestration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestrationServicerClient) RetryOrchestrationStep(ctx context.Context, in *RetryOrchestrationStepRequest, opts ...grpc.CallOption) (*RetryOrchestrationStepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryOrchestrationStepResponse)
+	err := c.cc.Invoke(ctx, OrchestrationServicer_RetryOrchestrationStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orchestrationServicerClient) CancelOrchestration(ctx context.Context, in *CancelOrchestrationRequest, opts ...grpc.CallOption) (*CancelOrchestrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrchestrationResponse)
+	err := c.cc.Invoke(ctx, OrchestrationServicer_CancelOrchestration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrchestrationServicerServer is the server API for OrchestrationServicer service.
+// All implementations must embed UnimplementedOrchestrationServicerServer
+// for forward compatibility.
+type OrchestrationServicerServer interface {
+	ListOrchestrations(context.Context, *ListOrchestrationsRequest) (*ListOrchestrationsResponse, error)
+	GetOrchestration(context.Context, *GetOrchestrationRequest) (*GetOrchestrationResponse, error)
+	WatchOrchestration(*WatchOrchestrationRequest, grpc.ServerStreamingServer[WatchOrchestrationResponse]) error
+	ResumeOrchestration(context.Context, *ResumeOrchestrationRequest) (*ResumeOrchestrationResponse, error)
+	RetryOrchestrationStep(context.Context, *RetryOrchestrationStepRequest) (*RetryOrchestrationStepResponse, error)
+	CancelOrchestration(context.Context, *CancelOrchestrationRequest) (*CancelOrchestrationResponse, error)
+	mustEmbedUnimplementedOrchestrationServicerServer()
+}
+
+// UnimplementedOrchestrationServicerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrchestrationServicerServer struct{}
+
+func (UnimplementedOrchestrationServicerServer) ListOrchestrations(context.Context, *ListOrchestrationsRequest) (*ListOrchestrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrchestrations not implemented")
+}
+func (UnimplementedOrchestrationServicerServer) GetOrchestration(context.Context, *GetOrchestrationRequest) (*GetOrchestrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrchestration not implemented")
+}
+func (UnimplementedOrchestrationServicerServer) WatchOrchestration(*WatchOrchestrationRequest, grpc.ServerStreamingServer[WatchOrchestrationResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrchestration not implemented")
+}
+func (UnimplementedOrchestrationServicerServer) ResumeOrchestration(context.Context, *ResumeOrchestrationRequest) (*ResumeOrchestrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeOrchestration not implemented")
+}
+func (UnimplementedOrchestrationServicerServer) RetryOrchestrationStep(context.Context, *RetryOrchestrationStepRequest) (*RetryOrchestrationStepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryOrchestrationStep not implemented")
+}
+func (UnimplementedOrchestrationServicerServer) CancelOrchestration(context.Context, *CancelOrchestrationRequest) (*CancelOrchestrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrchestration not implemented")
+}
+func (UnimplementedOrchestrationServicerServer) mustEmbedUnimplementedOrchestrationServicerServer() {}
+func (UnimplementedOrchestrationServicerServer) testEmbeddedByValue()                               {}
+
+// UnsafeOrchestrationServicerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrchestrationServicerServer will
+// result in compilation errors.
+type UnsafeOrchestrationServicerServer interface {
+	mustEmbedUnimplementedOrchestrationServicerServer()
+}
+
+func RegisterOrchestrationServicerServer(s grpc.ServiceRegistrar, srv OrchestrationServicerServer) {
+	// If the following call pancis, it indicates UnimplementedOrchestrationServicerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrchestrationServicer_ServiceDesc, srv)
+}
+
+func _OrchestrationServicer_ListOrchestrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrchestrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestrationServicerServer).ListOrchestrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestrationServicer_ListOrchestrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestrationServicerServer).ListOrchestrations(ctx, req.(*ListOrchestrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestrationServicer_GetOrchestration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrchestrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestrationServicerServer).GetOrchestration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestrationServicer_GetOrchestration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestrationServicerServer).GetOrchestration(ctx, req.(*GetOrchestrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestrationServicer_WatchOrchestration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrchestrationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrchestrationServicerServer).WatchOrchestration(m, &grpc.GenericServerStream[WatchOrchestrationRequest, WatchOrchestrationResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrchestrationServicer_WatchOrchestrationServer = grpc.ServerStreamingServer[WatchOrchestrationResponse]
+
+func _OrchestrationServicer_ResumeOrchestration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeOrchestrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestrationServicerServer).ResumeOrchestration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestrationServicer_ResumeOrchestration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestrationServicerServer).ResumeOrchestration(ctx, req.(*ResumeOrchestrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestrationServicer_RetryOrchestrationStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryOrchestrationStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestrationServicerServer).RetryOrchestrationStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestrationServicer_RetryOrchestrationStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestrationServicerServer).RetryOrchestrationStep(ctx, req.(*RetryOrchestrationStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrchestrationServicer_CancelOrchestration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrchestrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrchestrationServicerServer).CancelOrchestration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrchestrationServicer_CancelOrchestration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrchestrationServicerServer).CancelOrchestration(ctx, req.(*CancelOrchestrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrchestrationServicer_ServiceDesc is the grpc.ServiceDesc for OrchestrationServicer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrchestrationServicer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "foreverbull.stream.OrchestrationServicer",
+	HandlerType: (*OrchestrationServicerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOrchestrations",
+			Handler:    _OrchestrationServicer_ListOrchestrations_Handler,
+		},
+		{
+			MethodName: "GetOrchestration",
+			Handler:    _OrchestrationServicer_GetOrchestration_Handler,
+		},
+		{
+			MethodName: "ResumeOrchestration",
+			Handler:    _OrchestrationServicer_ResumeOrchestration_Handler,
+		},
+		{
+			MethodName: "RetryOrchestrationStep",
+			Handler:    _OrchestrationServicer_RetryOrchestrationStep_Handler,
+		},
+		{
+			MethodName: "CancelOrchestration",
+			Handler:    _OrchestrationServicer_CancelOrchestration_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrchestration",
+			Handler:       _OrchestrationServicer_WatchOrchestration_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "foreverbull/stream/orchestration_service.proto",
+}
@@ -2,19 +2,12 @@ package command
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 	st "github.com/lhjnilsson/foreverbull/pkg/service/stream"
 )
 
-func InstanceInterview(ctx context.Context, message stream.Message) error {
-	var instance st.InstanceInterviewCommand
-
-	err := message.ParsePayload(&instance)
-	if err != nil {
-		return fmt.Errorf("error unmarshalling InstanceInterview payload: %w", err)
-	}
+func InstanceInterview(ctx context.Context, message stream.Message, instance st.InstanceInterviewCommand) error {
 	/*
 		services := repository.Service{Conn: db}
 		instances := repository.Instance{Conn: db}
@@ -38,7 +31,7 @@ func InstanceInterview(ctx context.Context, message stream.Message) error {
 	return nil
 }
 
-func InstanceSanityCheck(ctx context.Context, message stream.Message) error {
+func InstanceSanityCheck(ctx context.Context, message stream.Message, instance st.InstanceSanityCheckCommand) error {
 	/*
 		db := message.MustGet(stream.DBDep).(postgres.Query)
 
@@ -100,7 +93,7 @@ func InstanceSanityCheck(ctx context.Context, message stream.Message) error {
 	return nil
 }
 
-func InstanceStop(ctx context.Context, message stream.Message) error {
+func InstanceStop(ctx context.Context, message stream.Message, instance st.InstanceStopCommand) error {
 	/*
 		instance := st.InstanceStopCommand{}
 		err := message.ParsePayload(&instance)
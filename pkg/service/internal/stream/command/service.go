@@ -4,31 +4,25 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/lhjnilsson/foreverbull/internal/container"
-	"github.com/lhjnilsson/foreverbull/internal/postgres"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 	"github.com/lhjnilsson/foreverbull/pkg/service/internal/repository"
 	"github.com/lhjnilsson/foreverbull/pkg/service/internal/stream/dependency"
 	ss "github.com/lhjnilsson/foreverbull/pkg/service/stream"
 )
 
-func UpdateServiceStatus(ctx context.Context, message stream.Message) error {
+func UpdateServiceStatus(ctx context.Context, message stream.Message, command ss.UpdateServiceStatusCommand) error {
 	return nil
 }
 
-func ServiceStart(ctx context.Context, message stream.Message) error {
-	postgres, isDB := message.MustGet(stream.DBDep).(postgres.Query)
-	if !isDB {
-		return fmt.Errorf("db dependency casting failed")
+func ServiceStart(ctx context.Context, message stream.Message, command ss.ServiceStartCommand) error {
+	postgres, err := stream.DB.Get(message)
+	if err != nil {
+		return fmt.Errorf("error getting db: %w", err)
 	}
 
-	container := message.MustGet(dependency.ContainerDep).(container.Engine)
-
-	command := ss.ServiceStartCommand{}
-
-	err := message.ParsePayload(&command)
+	container, err := dependency.Container.Get(message)
 	if err != nil {
-		return fmt.Errorf("error unmarshalling ServiceStart payload: %w", err)
+		return fmt.Errorf("error getting container engine: %w", err)
 	}
 
 	services := repository.Service{Conn: postgres}
@@ -1,7 +1,12 @@
 package dependency
 
-import "github.com/lhjnilsson/foreverbull/internal/stream"
+import (
+	"github.com/lhjnilsson/foreverbull/internal/container"
+	"github.com/lhjnilsson/foreverbull/internal/stream"
+)
 
 const (
 	ContainerDep stream.Dependency = "container"
 )
+
+var Container = stream.NewSingleton[container.Engine](ContainerDep) //nolint: gochecknoglobals
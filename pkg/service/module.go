@@ -5,57 +5,68 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/container"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 	"github.com/lhjnilsson/foreverbull/pkg/service/internal/repository"
 	"github.com/lhjnilsson/foreverbull/pkg/service/internal/stream/command"
+	"github.com/lhjnilsson/foreverbull/pkg/service/internal/stream/dependency"
+	ss "github.com/lhjnilsson/foreverbull/pkg/service/stream"
 	"github.com/nats-io/nats.go"
 	"go.uber.org/fx"
 )
 
 const StreamName = "service"
 
-type Stream stream.Stream
+type (
+	Stream              stream.Stream
+	DependencyContainer stream.DependencyContainer
+)
 
 var Module = fx.Options( //nolint: gochecknoglobals
 	fx.Provide(
-		func(jt nats.JetStreamContext, conn *pgxpool.Pool) (Stream, error) {
+		func(conn *pgxpool.Pool, containers container.Engine) DependencyContainer {
 			dc := stream.NewDependencyContainer()
 			dc.AddSingleton(stream.DBDep, conn)
-			return stream.NewNATSStream(jt, StreamName, dc, conn)
+			dc.AddSingleton(dependency.ContainerDep, containers)
+			return dc
+		},
+		func(jt nats.JetStreamContext, conn *pgxpool.Pool, dc DependencyContainer) (Stream, error) {
+			return stream.NewStream(jt, StreamName, dc, conn)
 		},
 	),
 	fx.Invoke(
 		func(conn *pgxpool.Pool) error {
 			return repository.CreateTables(context.Background(), conn)
 		},
-		func(lc fx.Lifecycle, stream Stream) error {
+		func(lc fx.Lifecycle, serviceStream Stream, dependencies DependencyContainer) error {
 			lc.Append(
 				fx.Hook{
 					OnStart: func(ctx context.Context) error {
-						err := stream.CommandSubscriber("service", "start", command.ServiceStart)
+						registry := stream.NewRegistry(StreamName, serviceStream, dependencies)
+						err := stream.Register(registry, ss.ServiceStart, command.ServiceStart)
 						if err != nil {
-							return fmt.Errorf("error subscribing to service.start: %w", err)
+							return fmt.Errorf("error registering service.start: %w", err)
 						}
-						err = stream.CommandSubscriber("instance", "interview", command.InstanceInterview)
+						err = stream.Register(registry, ss.InstanceInterview, command.InstanceInterview)
 						if err != nil {
-							return fmt.Errorf("error subscribing to instance.interview: %w", err)
+							return fmt.Errorf("error registering instance.interview: %w", err)
 						}
-						err = stream.CommandSubscriber("instance", "sanity_check", command.InstanceSanityCheck)
+						err = stream.Register(registry, ss.InstanceSanityCheck, command.InstanceSanityCheck)
 						if err != nil {
-							return fmt.Errorf("error subscribing to instance.sanity_check: %w", err)
+							return fmt.Errorf("error registering instance.sanity_check: %w", err)
 						}
-						err = stream.CommandSubscriber("instance", "stop", command.InstanceStop)
+						err = stream.Register(registry, ss.InstanceStop, command.InstanceStop)
 						if err != nil {
-							return fmt.Errorf("error subscribing to instance.stop: %w", err)
+							return fmt.Errorf("error registering instance.stop: %w", err)
 						}
-						err = stream.CommandSubscriber("service", "status", command.UpdateServiceStatus)
+						err = stream.Register(registry, ss.UpdateServiceStatus, command.UpdateServiceStatus)
 						if err != nil {
-							return fmt.Errorf("error subscribing to service.status: %w", err)
+							return fmt.Errorf("error registering service.status: %w", err)
 						}
 						return nil
 					},
 					OnStop: func(ctx context.Context) error {
-						err := stream.Unsubscribe()
+						err := serviceStream.Unsubscribe()
 						if err != nil {
 							return fmt.Errorf("error unsubscribing: %w", err)
 						}
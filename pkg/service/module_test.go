@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lhjnilsson/foreverbull/internal/container"
 	"github.com/lhjnilsson/foreverbull/internal/environment"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 	"github.com/lhjnilsson/foreverbull/internal/test_helper"
@@ -47,6 +48,7 @@ func (test *ServiceModuleTest) SetupTest() {
 	test.app = fx.New(
 		fx.Provide(
 			stream.New,
+			container.NewEngine,
 			func() *pgxpool.Pool {
 				return pool
 			},
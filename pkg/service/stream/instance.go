@@ -1,8 +1,6 @@
 package stream
 
 import (
-	"fmt"
-
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 )
 
@@ -10,49 +8,34 @@ type InstanceInterviewCommand struct {
 	ID string
 }
 
+var InstanceInterview = stream.NewCommand[InstanceInterviewCommand]("service", "instance", "interview") //nolint: gochecknoglobals
+
 func NewInstanceInterviewCommand(instanceID string) (stream.Message, error) {
-	entity := &InstanceInterviewCommand{
+	return InstanceInterview.NewMessage(InstanceInterviewCommand{
 		ID: instanceID,
-	}
-
-	msg, err := stream.NewMessage("service", "instance", "interview", entity)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
+	})
 }
 
 type InstanceStopCommand struct {
 	ID string
 }
 
+var InstanceStop = stream.NewCommand[InstanceStopCommand]("service", "instance", "stop") //nolint: gochecknoglobals
+
 func NewInstanceStopCommand(instanceID string) (stream.Message, error) {
-	entity := &InstanceStopCommand{
+	return InstanceStop.NewMessage(InstanceStopCommand{
 		ID: instanceID,
-	}
-
-	msg, err := stream.NewMessage("service", "instance", "stop", entity)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
+	})
 }
 
 type InstanceSanityCheckCommand struct {
 	IDs []string
 }
 
+var InstanceSanityCheck = stream.NewCommand[InstanceSanityCheckCommand]("service", "instance", "sanity_check") //nolint: gochecknoglobals
+
 func NewInstanceSanityCheckCommand(instanceIDs []string) (stream.Message, error) {
-	entity := &InstanceSanityCheckCommand{
+	return InstanceSanityCheck.NewMessage(InstanceSanityCheckCommand{
 		IDs: instanceIDs,
-	}
-
-	msg, err := stream.NewMessage("service", "instance", "sanity_check", entity)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
+	})
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/lhjnilsson/foreverbull/internal/stream"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
+	"github.com/lhjnilsson/foreverbull/pkg/service/internal/stream/dependency"
 )
 
 type UpdateServiceStatusCommand struct {
@@ -19,19 +20,18 @@ type ServiceStartCommand struct {
 	InstanceID string
 }
 
+var ( //nolint: gochecknoglobals
+	UpdateServiceStatus = stream.NewCommand[UpdateServiceStatusCommand]("service", "service", "status")
+	ServiceStart        = stream.NewCommand[ServiceStartCommand]("service", "service", "start",
+		stream.DB, dependency.Container)
+)
+
 func NewUpdateServiceStatusCommand(image string, status pb.Service_Status_Status, err error) (stream.Message, error) {
-	entity := &UpdateServiceStatusCommand{
+	return UpdateServiceStatus.NewMessage(UpdateServiceStatusCommand{
 		Image:  image,
 		Status: status,
 		Error:  err,
-	}
-
-	msg, err := stream.NewMessage("service", "service", "status", entity)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
+	})
 }
 
 func NewInstanceID() string {
@@ -39,17 +39,10 @@ func NewInstanceID() string {
 }
 
 func NewServiceStartCommand(image, instanceID string) (stream.Message, error) {
-	entity := &ServiceStartCommand{
+	return ServiceStart.NewMessage(ServiceStartCommand{
 		Image:      image,
 		InstanceID: instanceID,
-	}
-
-	msg, err := stream.NewMessage("service", "service", "start", entity)
-	if err != nil {
-		return nil, fmt.Errorf("error creating message: %w", err)
-	}
-
-	return msg, nil
+	})
 }
 
 func NewServiceInterviewOrchestration(image string) (*stream.MessageOrchestration, error) {
@@ -0,0 +1,360 @@
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lhjnilsson/foreverbull/internal/socket"
+	worker_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
+	"go.nanomsg.org/mangos/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// PoolMetadataKey selects the pool of a WorkerBroker call.
+	PoolMetadataKey = "foreverbull-pool"
+	// PoolTokenMetadataKey carries the token of the pool, it is given to workers with
+	// the pool in ExecutionConfiguration.
+	PoolTokenMetadataKey = "foreverbull-pool-token"
+)
+
+func newPoolToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("error reading random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// Broker serves the GRPC worker transport on the main gRPC server, so workers can reach
+// their pool without direct access to the backtest port range.
+type Broker struct {
+	worker_pb.UnimplementedWorkerBrokerServer
+
+	lock  sync.RWMutex
+	pools map[string]*pool
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		pools: make(map[string]*pool),
+	}
+}
+
+func (b *Broker) register(p *pool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.pools[p.id] = p
+}
+
+func (b *Broker) deregister(id string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.pools, id)
+}
+
+func (b *Broker) pool(ctx context.Context) (*pool, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	ids := md.Get(PoolMetadataKey)
+	if len(ids) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s metadata is required", PoolMetadataKey)
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	p, exists := b.pools[ids[0]]
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "pool %s not found", ids[0])
+	}
+
+	tokens := md.Get(PoolTokenMetadataKey)
+	if len(tokens) == 0 || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(p.token)) != 1 {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token for pool %s", ids[0])
+	}
+
+	return p, nil
+}
+
+func (b *Broker) Connect(stream worker_pb.WorkerBroker_ConnectServer) error {
+	p, err := b.pool(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	requester, isStream := p.Socket.(*streamRequester)
+	if !isStream {
+		return status.Error(codes.FailedPrecondition, "pool does not use the grpc transport")
+	}
+
+	return requester.serve(stream)
+}
+
+func (b *Broker) Namespace(ctx context.Context, request *worker_pb.NamespaceRequest) (*worker_pb.NamespaceResponse, error) {
+	p, err := b.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &worker_pb.NamespaceResponse{}
+	p.namespaceRequest(request, response)
+
+	return response, nil
+}
+
+type streamResult struct {
+	response *worker_pb.WorkerResponse
+	err      error
+}
+
+type streamCall struct {
+	request *worker_pb.WorkerRequest
+	result  chan streamResult
+}
+
+// streamOptions takes the socket options given to Request, so the pool can treat the
+// GRPC transport as any other requester.
+type streamOptions struct {
+	sendTimeout time.Duration
+	readTimeout time.Duration
+	failNoPeers bool
+}
+
+func (o *streamOptions) SetOption(name string, value interface{}) error {
+	var isType bool
+
+	switch name {
+	case mangos.OptionSendDeadline:
+		o.sendTimeout, isType = value.(time.Duration)
+	case mangos.OptionRecvDeadline:
+		o.readTimeout, isType = value.(time.Duration)
+	case mangos.OptionFailNoPeers:
+		o.failNoPeers, isType = value.(bool)
+	default:
+		return fmt.Errorf("unsupported option %s", name)
+	}
+
+	if !isType {
+		return fmt.Errorf("invalid value for option %s", name)
+	}
+
+	return nil
+}
+
+func timeoutAfter(timeout time.Duration) <-chan time.Time {
+	if timeout <= 0 {
+		return nil
+	}
+
+	return time.After(timeout)
+}
+
+// streamRequester hands requests to the workers connected with Broker.Connect, every
+// request goes to the first idle worker.
+type streamRequester struct {
+	calls     chan *streamCall
+	peers     atomic.Int32
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	peerIDs     atomic.Uint32
+	replies     map[uint32]time.Time
+	repliesLock sync.Mutex
+}
+
+func newStreamRequester() *streamRequester {
+	return &streamRequester{
+		calls:   make(chan *streamCall),
+		closed:  make(chan struct{}),
+		replies: make(map[uint32]time.Time),
+	}
+}
+
+func (r *streamRequester) GetHost() string {
+	return ""
+}
+
+func (r *streamRequester) GetPort() int {
+	return 0
+}
+
+func (r *streamRequester) Peers() int {
+	return int(r.peers.Load())
+}
+
+func (r *streamRequester) Replies() map[uint32]time.Time {
+	r.repliesLock.Lock()
+	defer r.repliesLock.Unlock()
+
+	replies := make(map[uint32]time.Time, len(r.replies))
+	for id, at := range r.replies {
+		replies[id] = at
+	}
+
+	return replies
+}
+
+func (r *streamRequester) replied(id uint32) {
+	r.repliesLock.Lock()
+	defer r.repliesLock.Unlock()
+
+	r.replies[id] = time.Now()
+}
+
+func (r *streamRequester) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+	})
+
+	return nil
+}
+
+func (r *streamRequester) Request(msg proto.Message, reply proto.Message, options ...func(socket.OptionSetter) error) error {
+	request, isRequest := msg.(*worker_pb.WorkerRequest)
+	if !isRequest {
+		return fmt.Errorf("unsupported request %T", msg)
+	}
+
+	opts := &streamOptions{}
+	for _, opt := range options {
+		if err := opt(opts); err != nil {
+			return fmt.Errorf("failed to set option: %w", err)
+		}
+	}
+
+	if opts.failNoPeers && r.Peers() == 0 {
+		return socket.ErrNoPeers
+	}
+
+	call := &streamCall{request: request, result: make(chan streamResult, 1)}
+
+	select {
+	case r.calls <- call:
+	case <-timeoutAfter(opts.sendTimeout):
+		return socket.ErrSendTimeout
+	case <-r.closed:
+		return socket.ErrClosed
+	}
+
+	select {
+	case result := <-call.result:
+		if result.err != nil {
+			return result.err
+		}
+
+		if reply != nil {
+			proto.Merge(reply, result.response)
+		}
+
+		return nil
+	case <-timeoutAfter(opts.readTimeout):
+		return socket.ErrReadTimeout
+	case <-r.closed:
+		return socket.ErrClosed
+	}
+}
+
+// retry hands a call that lost its worker to another worker, the call fails when no
+// worker is left.
+func (r *streamRequester) retry(call *streamCall) {
+	if r.Peers() == 0 {
+		call.result <- streamResult{err: socket.ErrNoPeers}
+		return
+	}
+
+	go func() {
+		select {
+		case r.calls <- call:
+		case <-r.closed:
+			call.result <- streamResult{err: socket.ErrClosed}
+		}
+	}()
+}
+
+func (r *streamRequester) serve(stream worker_pb.WorkerBroker_ConnectServer) error {
+	id := r.peerIDs.Add(1)
+
+	r.replied(id)
+	r.peers.Add(1)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	responses := make(chan *worker_pb.WorkerResponse)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case responses <- response:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	disconnected := func(call *streamCall, err error) error {
+		r.peers.Add(-1)
+		r.repliesLock.Lock()
+		delete(r.replies, id)
+		r.repliesLock.Unlock()
+
+		if call != nil {
+			r.retry(call)
+		}
+
+		// The worker closing its side of the stream is a regular disconnect
+		if errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled {
+			return nil
+		}
+
+		return err
+	}
+
+	for {
+		var call *streamCall
+
+		select {
+		case call = <-r.calls:
+		case err := <-recvErr:
+			return disconnected(nil, err)
+		case <-r.closed:
+			return disconnected(nil, nil)
+		}
+
+		if err := stream.Send(call.request); err != nil {
+			return disconnected(call, err)
+		}
+
+		select {
+		case response := <-responses:
+			r.replied(id)
+			call.result <- streamResult{response: response}
+		case err := <-recvErr:
+			return disconnected(call, err)
+		case <-r.closed:
+			return disconnected(call, nil)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package worker_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	finance_pb "github.com/lhjnilsson/foreverbull/pkg/pb/finance"
+	pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
+	"github.com/lhjnilsson/foreverbull/pkg/service/worker"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type BrokerTest struct {
+	suite.Suite
+
+	broker *worker.Broker
+	server *grpc.Server
+	client pb.WorkerBrokerClient
+	conn   *grpc.ClientConn
+}
+
+func (test *BrokerTest) SetupTest() {
+	listener := bufconn.Listen(1024 * 1024)
+
+	test.broker = worker.NewBroker()
+	test.server = grpc.NewServer()
+	pb.RegisterWorkerBrokerServer(test.server, test.broker)
+
+	go func() {
+		_ = test.server.Serve(listener)
+	}()
+
+	var err error
+	test.conn, err = grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	test.Require().NoError(err)
+
+	test.client = pb.NewWorkerBrokerClient(test.conn)
+}
+
+func (test *BrokerTest) TearDownTest() {
+	test.conn.Close()
+	test.server.Stop()
+}
+
+func TestBroker(t *testing.T) {
+	suite.Run(t, new(BrokerTest))
+}
+
+func (test *BrokerTest) algorithm() *pb.Algorithm {
+	return &pb.Algorithm{
+		Functions:  []*pb.Algorithm_Function{{Name: "test", ParallelExecution: true}},
+		Namespaces: []string{"stats"},
+	}
+}
+
+// poolContext authenticates calls as a worker of the configured pool.
+func poolContext(ctx context.Context, configuration *pb.ExecutionConfiguration) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, worker.PoolMetadataKey, configuration.Pool,
+		worker.PoolTokenMetadataKey, configuration.PoolToken)
+}
+
+// connect runs a worker that answers every request with handler until the stream ends.
+func (test *BrokerTest) connect(ctx context.Context, configuration *pb.ExecutionConfiguration,
+	handler func(*pb.WorkerRequest) *pb.WorkerResponse,
+) pb.WorkerBroker_ConnectClient {
+	ctx = poolContext(ctx, configuration)
+
+	stream, err := test.client.Connect(ctx)
+	test.Require().NoError(err)
+
+	go func() {
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				return
+			}
+
+			if err := stream.Send(handler(request)); err != nil {
+				return
+			}
+		}
+	}()
+
+	return stream
+}
+
+func (test *BrokerTest) TestProcess() {
+	pool, err := worker.NewPool(context.TODO(), test.algorithm(), worker.WithBroker(test.broker))
+	test.Require().NoError(err)
+
+	defer pool.Close()
+
+	configuration := pool.Configure()
+	test.Equal(pb.WorkerTransport_GRPC, configuration.Transport)
+	test.NotEmpty(configuration.Pool)
+	test.Zero(configuration.BrokerPort)
+	test.Zero(configuration.NamespacePort)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := func(request *pb.WorkerRequest) *pb.WorkerResponse {
+		if request.Task == worker.HeartbeatTask {
+			return &pb.WorkerResponse{Task: request.Task}
+		}
+
+		value, err := structpb.NewStruct(map[string]interface{}{"count": 1})
+		test.NoError(err)
+
+		nsCtx := poolContext(ctx, configuration)
+		_, err = test.client.Namespace(nsCtx, &pb.NamespaceRequest{
+			Key: "stats", Type: pb.NamespaceRequestType_INCREMENT, Value: value,
+		})
+		test.NoError(err)
+
+		return &pb.WorkerResponse{Task: request.Task, Orders: []*finance_pb.Order{
+			{Symbol: request.Symbols[0], Amount: 10},
+		}}
+	}
+
+	// Two workers share the requests of the parallel function
+	test.connect(ctx, configuration, handler)
+	test.connect(ctx, configuration, handler)
+
+	orders, err := pool.Process(context.TODO(), time.Now(), []string{"AAPL", "TSLA", "MSFT"}, &finance_pb.Portfolio{})
+	test.Require().NoError(err)
+	test.Len(orders, 3)
+	test.InDelta(3, pool.Namespace().Get("stats").Fields["count"].GetNumberValue(), 0)
+}
+
+func (test *BrokerTest) TestUnknownPool() {
+	stream, err := test.client.Connect(context.Background())
+	test.Require().NoError(err)
+
+	_, err = stream.Recv()
+	test.Equal(codes.InvalidArgument, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), worker.PoolMetadataKey, "unknown")
+	_, err = test.client.Namespace(ctx, &pb.NamespaceRequest{Key: "stats"})
+	test.Equal(codes.NotFound, status.Code(err))
+
+	pool, err := worker.NewPool(context.TODO(), test.algorithm(), worker.WithBroker(test.broker))
+	test.Require().NoError(err)
+
+	configuration := pool.Configure()
+	test.NotEmpty(configuration.PoolToken)
+
+	// The pool alone is not enough to reach it
+	ctx = metadata.AppendToOutgoingContext(context.Background(), worker.PoolMetadataKey, configuration.Pool)
+	_, err = test.client.Namespace(ctx, &pb.NamespaceRequest{Key: "stats"})
+	test.Equal(codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.AppendToOutgoingContext(ctx, worker.PoolTokenMetadataKey, "invalid")
+	_, err = test.client.Namespace(ctx, &pb.NamespaceRequest{Key: "stats"})
+	test.Equal(codes.Unauthenticated, status.Code(err))
+
+	_, err = test.client.Namespace(poolContext(context.Background(), configuration), &pb.NamespaceRequest{Key: "stats"})
+	test.NoError(err)
+
+	test.Require().NoError(pool.Close())
+
+	// Closed pools are removed from the broker
+	_, err = test.client.Namespace(poolContext(context.Background(), configuration), &pb.NamespaceRequest{Key: "stats"})
+	test.Equal(codes.NotFound, status.Code(err))
+}
+
+func (test *BrokerTest) TestWorkerDisconnect() {
+	pool, err := worker.NewPool(context.TODO(), test.algorithm(), worker.WithBroker(test.broker),
+		worker.WithFunctionTimeout(time.Second*5))
+	test.Require().NoError(err)
+
+	defer pool.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	defer close(done)
+
+	block := make(chan struct{})
+	handled := 0
+	test.connect(ctx, pool.Configure(), func(request *pb.WorkerRequest) *pb.WorkerResponse {
+		handled++
+		if handled > 1 {
+			// The worker dies while processing the request
+			close(block)
+			<-done
+		}
+
+		return &pb.WorkerResponse{Task: request.Task}
+	})
+
+	_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+	test.Require().NoError(err)
+
+	go func() {
+		<-block
+		cancel()
+	}()
+
+	_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+	test.ErrorIs(err, worker.ErrNoWorkers)
+	test.ErrorIs(pool.Err(), worker.ErrNoWorkers)
+}
+
+func (test *BrokerTest) TestFunctionTimeout() {
+	pool, err := worker.NewPool(context.TODO(), test.algorithm(), worker.WithBroker(test.broker),
+		worker.WithFunctionTimeout(time.Millisecond*200))
+	test.Require().NoError(err)
+
+	defer pool.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	test.connect(ctx, pool.Configure(), func(request *pb.WorkerRequest) *pb.WorkerResponse {
+		<-ctx.Done()
+		return &pb.WorkerResponse{Task: request.Task}
+	})
+
+	_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+	test.ErrorIs(err, worker.ErrWorkerTimeout)
+}
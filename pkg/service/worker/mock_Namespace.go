@@ -3,6 +3,7 @@
 package worker
 
 import (
+	service "github.com/lhjnilsson/foreverbull/pkg/pb/service"
 	mock "github.com/stretchr/testify/mock"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 )
@@ -12,6 +13,82 @@ type MockNamespace struct {
 	mock.Mock
 }
 
+// Append provides a mock function with given fields: key, values
+func (_m *MockNamespace) Append(key string, values *structpb.Struct) (*structpb.Struct, error) {
+	ret := _m.Called(key, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 *structpb.Struct
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *structpb.Struct) (*structpb.Struct, error)); ok {
+		return rf(key, values)
+	}
+	if rf, ok := ret.Get(0).(func(string, *structpb.Struct) *structpb.Struct); ok {
+		r0 = rf(key, values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structpb.Struct)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *structpb.Struct) error); ok {
+		r1 = rf(key, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompareAndSet provides a mock function with given fields: key, expected, value
+func (_m *MockNamespace) CompareAndSet(key string, expected *structpb.Struct, value *structpb.Struct) (bool, error) {
+	ret := _m.Called(key, expected, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareAndSet")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *structpb.Struct, *structpb.Struct) (bool, error)); ok {
+		return rf(key, expected, value)
+	}
+	if rf, ok := ret.Get(0).(func(string, *structpb.Struct, *structpb.Struct) bool); ok {
+		r0 = rf(key, expected, value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *structpb.Struct, *structpb.Struct) error); ok {
+		r1 = rf(key, expected, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: key, fields
+func (_m *MockNamespace) Delete(key string, fields []string) error {
+	ret := _m.Called(key, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(key, fields)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Flush provides a mock function with given fields:
 func (_m *MockNamespace) Flush() {
 	_m.Called()
@@ -37,6 +114,71 @@ func (_m *MockNamespace) Get(key string) *structpb.Struct {
 	return r0
 }
 
+// GetMany provides a mock function with given fields: keys
+func (_m *MockNamespace) GetMany(keys []string) (map[string]*structpb.Struct, error) {
+	ret := _m.Called(keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMany")
+	}
+
+	var r0 map[string]*structpb.Struct
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (map[string]*structpb.Struct, error)); ok {
+		return rf(keys)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string]*structpb.Struct); ok {
+		r0 = rf(keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*structpb.Struct)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Increment provides a mock function with given fields: key, deltas
+func (_m *MockNamespace) Increment(key string, deltas *structpb.Struct) (*structpb.Struct, error) {
+	ret := _m.Called(key, deltas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Increment")
+	}
+
+	var r0 *structpb.Struct
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *structpb.Struct) (*structpb.Struct, error)); ok {
+		return rf(key, deltas)
+	}
+	if rf, ok := ret.Get(0).(func(string, *structpb.Struct) *structpb.Struct); ok {
+		r0 = rf(key, deltas)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*structpb.Struct)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *structpb.Struct) error); ok {
+		r1 = rf(key, deltas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: values
+func (_m *MockNamespace) Restore(values map[string]*structpb.Struct) {
+	_m.Called(values)
+}
+
 // Set provides a mock function with given fields: key, value
 func (_m *MockNamespace) Set(key string, value *structpb.Struct) error {
 	ret := _m.Called(key, value)
@@ -55,6 +197,32 @@ func (_m *MockNamespace) Set(key string, value *structpb.Struct) error {
 	return r0
 }
 
+// Snapshot provides a mock function with given fields: scopes
+func (_m *MockNamespace) Snapshot(scopes ...service.NamespaceScope) map[string]*structpb.Struct {
+	_va := make([]interface{}, len(scopes))
+	for _i := range scopes {
+		_va[_i] = scopes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 map[string]*structpb.Struct
+	if rf, ok := ret.Get(0).(func(...service.NamespaceScope) map[string]*structpb.Struct); ok {
+		r0 = rf(scopes...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*structpb.Struct)
+		}
+	}
+
+	return r0
+}
+
 // NewMockNamespace creates a new instance of MockNamespace. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockNamespace(t interface {
@@ -56,6 +56,44 @@ func (_m *MockPool) Configure() *service.ExecutionConfiguration {
 	return r0
 }
 
+// Err provides a mock function with given fields:
+func (_m *MockPool) Err() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Err")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Namespace provides a mock function with given fields:
+func (_m *MockPool) Namespace() Namespace {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Namespace")
+	}
+
+	var r0 Namespace
+	if rf, ok := ret.Get(0).(func() Namespace); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(Namespace)
+		}
+	}
+
+	return r0
+}
+
 // Process provides a mock function with given fields: ctx, timestamp, symbols, portfolio
 func (_m *MockPool) Process(ctx context.Context, timestamp time.Time, symbols []string, portfolio *finance.Portfolio) ([]*finance.Order, error) {
 	ret := _m.Called(ctx, timestamp, symbols, portfolio)
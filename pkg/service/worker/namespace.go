@@ -2,19 +2,45 @@ package worker
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"sync"
 
+	worker_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
+var (
+	ErrNamespaceNotFound = errors.New("namespace not found")
+	ErrInvalidOperation  = errors.New("invalid namespace operation")
+)
+
 type Namespace interface {
 	Get(key string) *structpb.Struct
+	// GetMany returns a consistent copy of several namespaces.
+	GetMany(keys []string) (map[string]*structpb.Struct, error)
 	Set(key string, value *structpb.Struct) error
+	// Increment adds the numeric fields of deltas and returns the new values.
+	Increment(key string, deltas *structpb.Struct) (*structpb.Struct, error)
+	// Append adds the fields of values to the stored lists and returns the new lists.
+	Append(key string, values *structpb.Struct) (*structpb.Struct, error)
+	Delete(key string, fields []string) error
+	// CompareAndSet sets value if every field of expected matches the stored field, a
+	// null value in expected matches a missing field.
+	CompareAndSet(key string, expected, value *structpb.Struct) (bool, error)
+	// Flush clears the period scoped namespaces.
 	Flush()
+	// Snapshot returns a copy of the namespaces in the given scopes, or all namespaces
+	// when no scope is given.
+	Snapshot(scopes ...worker_pb.NamespaceScope) map[string]*structpb.Struct
+	// Restore replaces the values of the namespaces, unknown namespaces are ignored.
+	Restore(values map[string]*structpb.Struct)
 }
 
 type namespaceContainer struct {
 	value *structpb.Struct
+	scope worker_pb.NamespaceScope
 	sync  sync.Mutex
 }
 
@@ -22,7 +48,7 @@ type namespace struct {
 	values map[string]*namespaceContainer
 }
 
-func CreateNamespace(namespaces []string) Namespace {
+func CreateNamespace(namespaces []string, scopes map[string]worker_pb.NamespaceScope) Namespace {
 	nspace := &namespace{
 		values: make(map[string]*namespaceContainer),
 	}
@@ -31,43 +57,243 @@ func CreateNamespace(namespaces []string) Namespace {
 			value: &structpb.Struct{
 				Fields: make(map[string]*structpb.Value),
 			},
+			scope: scopes[n],
 		}
 	}
 
 	return nspace
 }
 
+func (n *namespace) container(key string) (*namespaceContainer, error) {
+	container, ok := n.values[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNamespaceNotFound, key)
+	}
+
+	return container, nil
+}
+
 func (n *namespace) Get(key string) *structpb.Struct {
 	container, ok := n.values[key]
 	if !ok {
 		return nil
 	}
 
-	return container.value
+	container.sync.Lock()
+	defer container.sync.Unlock()
+
+	value, _ := proto.Clone(container.value).(*structpb.Struct)
+
+	return value
+}
+
+func (n *namespace) GetMany(keys []string) (map[string]*structpb.Struct, error) {
+	containers := make(map[string]*namespaceContainer, len(keys))
+
+	for _, key := range keys {
+		container, err := n.container(key)
+		if err != nil {
+			return nil, err
+		}
+
+		containers[key] = container
+	}
+
+	// Locks are taken in sorted order so concurrent reads can not deadlock
+	sorted := make([]string, 0, len(containers))
+	for key := range containers {
+		sorted = append(sorted, key)
+	}
+
+	slices.Sort(sorted)
+
+	for _, key := range sorted {
+		containers[key].sync.Lock()
+		defer containers[key].sync.Unlock()
+	}
+
+	values := make(map[string]*structpb.Struct, len(containers))
+	for key, container := range containers {
+		values[key], _ = proto.Clone(container.value).(*structpb.Struct)
+	}
+
+	return values, nil
 }
 
 func (n *namespace) Set(key string, value *structpb.Struct) error {
-	container, ok := n.values[key]
-	if !ok {
-		return errors.New("namespace not found")
+	container, err := n.container(key)
+	if err != nil {
+		return err
 	}
 
 	container.sync.Lock()
 	defer container.sync.Unlock()
 
-	for k, v := range value.Fields {
+	for k, v := range value.GetFields() {
 		container.value.Fields[k] = v
 	}
 
 	return nil
 }
 
+func (n *namespace) Increment(key string, deltas *structpb.Struct) (*structpb.Struct, error) {
+	container, err := n.container(key)
+	if err != nil {
+		return nil, err
+	}
+
+	container.sync.Lock()
+	defer container.sync.Unlock()
+
+	result := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(deltas.GetFields()))}
+
+	for field, delta := range deltas.GetFields() {
+		if _, ok := delta.GetKind().(*structpb.Value_NumberValue); !ok {
+			return nil, fmt.Errorf("%w: increment of %s is not a number", ErrInvalidOperation, field)
+		}
+
+		var current float64
+
+		if stored, exists := container.value.Fields[field]; exists {
+			if _, ok := stored.GetKind().(*structpb.Value_NumberValue); !ok {
+				return nil, fmt.Errorf("%w: %s is not a number", ErrInvalidOperation, field)
+			}
+
+			current = stored.GetNumberValue()
+		}
+
+		result.Fields[field] = structpb.NewNumberValue(current + delta.GetNumberValue())
+	}
+
+	for field, value := range result.Fields {
+		container.value.Fields[field] = value
+	}
+
+	return result, nil
+}
+
+func (n *namespace) Append(key string, values *structpb.Struct) (*structpb.Struct, error) {
+	container, err := n.container(key)
+	if err != nil {
+		return nil, err
+	}
+
+	container.sync.Lock()
+	defer container.sync.Unlock()
+
+	result := &structpb.Struct{Fields: make(map[string]*structpb.Value, len(values.GetFields()))}
+
+	for field, value := range values.GetFields() {
+		list := &structpb.ListValue{}
+
+		if stored, exists := container.value.Fields[field]; exists {
+			if _, ok := stored.GetKind().(*structpb.Value_ListValue); !ok {
+				return nil, fmt.Errorf("%w: %s is not a list", ErrInvalidOperation, field)
+			}
+
+			list, _ = proto.Clone(stored.GetListValue()).(*structpb.ListValue)
+		}
+
+		list.Values = append(list.Values, value)
+		result.Fields[field] = structpb.NewListValue(list)
+	}
+
+	for field, value := range result.Fields {
+		container.value.Fields[field] = value
+	}
+
+	return result, nil
+}
+
+func (n *namespace) Delete(key string, fields []string) error {
+	container, err := n.container(key)
+	if err != nil {
+		return err
+	}
+
+	container.sync.Lock()
+	defer container.sync.Unlock()
+
+	for _, field := range fields {
+		delete(container.value.Fields, field)
+	}
+
+	return nil
+}
+
+func (n *namespace) CompareAndSet(key string, expected, value *structpb.Struct) (bool, error) {
+	container, err := n.container(key)
+	if err != nil {
+		return false, err
+	}
+
+	container.sync.Lock()
+	defer container.sync.Unlock()
+
+	for field, want := range expected.GetFields() {
+		stored, exists := container.value.Fields[field]
+		if !exists {
+			if _, isNull := want.GetKind().(*structpb.Value_NullValue); isNull {
+				continue
+			}
+
+			return false, nil
+		}
+
+		if !proto.Equal(stored, want) {
+			return false, nil
+		}
+	}
+
+	for field, v := range value.GetFields() {
+		container.value.Fields[field] = v
+	}
+
+	return true, nil
+}
+
 func (n *namespace) Flush() {
 	for _, v := range n.values {
+		if v.scope != worker_pb.NamespaceScope_PERIOD {
+			continue
+		}
+
 		v.sync.Lock()
-		defer v.sync.Unlock()
 		v.value = &structpb.Struct{
 			Fields: make(map[string]*structpb.Value),
 		}
+		v.sync.Unlock()
+	}
+}
+
+func (n *namespace) Snapshot(scopes ...worker_pb.NamespaceScope) map[string]*structpb.Struct {
+	snapshot := make(map[string]*structpb.Struct)
+
+	for key, v := range n.values {
+		if len(scopes) > 0 && !slices.Contains(scopes, v.scope) {
+			continue
+		}
+
+		v.sync.Lock()
+		snapshot[key], _ = proto.Clone(v.value).(*structpb.Struct)
+		v.sync.Unlock()
+	}
+
+	return snapshot
+}
+
+func (n *namespace) Restore(values map[string]*structpb.Struct) {
+	for key, value := range values {
+		container, ok := n.values[key]
+		if !ok || value == nil {
+			continue
+		}
+
+		container.sync.Lock()
+		container.value, _ = proto.Clone(value).(*structpb.Struct)
+		if container.value.Fields == nil {
+			container.value.Fields = make(map[string]*structpb.Value)
+		}
+		container.sync.Unlock()
 	}
 }
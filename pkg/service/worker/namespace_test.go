@@ -1,9 +1,13 @@
 package worker_test
 
 import (
+	"sync"
 	"testing"
 
+	pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
+	"github.com/lhjnilsson/foreverbull/pkg/service/worker"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type ContainerTest struct {
@@ -19,3 +23,168 @@ func (test *ContainerTest) TearDownTest() {
 func TestNamespace(t *testing.T) {
 	suite.Run(t, new(ContainerTest))
 }
+
+func (test *ContainerTest) value(key string, value interface{}) *structpb.Struct {
+	s, err := structpb.NewStruct(map[string]interface{}{key: value})
+	test.Require().NoError(err)
+
+	return s
+}
+
+func (test *ContainerTest) TestScopes() {
+	namespace := worker.CreateNamespace([]string{"period", "execution", "session"}, map[string]pb.NamespaceScope{
+		"execution": pb.NamespaceScope_EXECUTION,
+		"session":   pb.NamespaceScope_SESSION,
+	})
+
+	for _, key := range []string{"period", "execution", "session"} {
+		test.Require().NoError(namespace.Set(key, test.value("counter", 1)))
+	}
+
+	namespace.Flush()
+
+	test.Empty(namespace.Get("period").Fields)
+	test.InDelta(1, namespace.Get("execution").Fields["counter"].GetNumberValue(), 0)
+	test.InDelta(1, namespace.Get("session").Fields["counter"].GetNumberValue(), 0)
+}
+
+func (test *ContainerTest) TestSnapshot() {
+	namespace := worker.CreateNamespace([]string{"period", "session"}, map[string]pb.NamespaceScope{
+		"session": pb.NamespaceScope_SESSION,
+	})
+	test.Require().NoError(namespace.Set("period", test.value("counter", 1)))
+	test.Require().NoError(namespace.Set("session", test.value("counter", 2)))
+
+	snapshot := namespace.Snapshot()
+	test.Len(snapshot, 2)
+
+	snapshot = namespace.Snapshot(pb.NamespaceScope_SESSION)
+	test.Require().Len(snapshot, 1)
+	test.InDelta(2, snapshot["session"].Fields["counter"].GetNumberValue(), 0)
+
+	// Snapshot must not change when the namespace does
+	test.Require().NoError(namespace.Set("session", test.value("counter", 3)))
+	test.InDelta(2, snapshot["session"].Fields["counter"].GetNumberValue(), 0)
+}
+
+func (test *ContainerTest) TestRestore() {
+	namespace := worker.CreateNamespace([]string{"model"}, map[string]pb.NamespaceScope{
+		"model": pb.NamespaceScope_EXECUTION,
+	})
+
+	namespace.Restore(map[string]*structpb.Struct{
+		"model":   test.value("weight", 0.5),
+		"unknown": test.value("weight", 1),
+	})
+
+	test.InDelta(0.5, namespace.Get("model").Fields["weight"].GetNumberValue(), 0)
+	test.Nil(namespace.Get("unknown"))
+	test.Require().NoError(namespace.Set("model", test.value("bias", 1)))
+	test.Len(namespace.Get("model").Fields, 2)
+}
+
+func (test *ContainerTest) TestOperations() {
+	namespace := worker.CreateNamespace([]string{"stats", "other"}, nil)
+
+	test.Run("increment", func() {
+		value, err := namespace.Increment("stats", test.value("count", 2))
+		test.Require().NoError(err)
+		test.InDelta(2, value.Fields["count"].GetNumberValue(), 0)
+
+		value, err = namespace.Increment("stats", test.value("count", 1.5))
+		test.Require().NoError(err)
+		test.InDelta(3.5, value.Fields["count"].GetNumberValue(), 0)
+
+		_, err = namespace.Increment("stats", test.value("count", "a"))
+		test.ErrorIs(err, worker.ErrInvalidOperation)
+	})
+	test.Run("append", func() {
+		value, err := namespace.Append("stats", test.value("symbols", "AAPL"))
+		test.Require().NoError(err)
+		test.Len(value.Fields["symbols"].GetListValue().Values, 1)
+
+		value, err = namespace.Append("stats", test.value("symbols", "TSLA"))
+		test.Require().NoError(err)
+		test.Equal([]interface{}{"AAPL", "TSLA"}, value.Fields["symbols"].GetListValue().AsSlice())
+
+		_, err = namespace.Append("stats", test.value("count", 1))
+		test.ErrorIs(err, worker.ErrInvalidOperation)
+	})
+	test.Run("compare and set", func() {
+		swapped, err := namespace.CompareAndSet("stats", test.value("leader", nil), test.value("leader", "AAPL"))
+		test.Require().NoError(err)
+		test.True(swapped)
+
+		swapped, err = namespace.CompareAndSet("stats", test.value("leader", "TSLA"), test.value("leader", "MSFT"))
+		test.Require().NoError(err)
+		test.False(swapped)
+		test.Equal("AAPL", namespace.Get("stats").Fields["leader"].GetStringValue())
+
+		swapped, err = namespace.CompareAndSet("stats", test.value("leader", "AAPL"), test.value("leader", "MSFT"))
+		test.Require().NoError(err)
+		test.True(swapped)
+		test.Equal("MSFT", namespace.Get("stats").Fields["leader"].GetStringValue())
+	})
+	test.Run("delete", func() {
+		test.Require().NoError(namespace.Delete("stats", []string{"leader", "missing"}))
+		test.NotContains(namespace.Get("stats").Fields, "leader")
+		test.Contains(namespace.Get("stats").Fields, "count")
+	})
+	test.Run("get many", func() {
+		test.Require().NoError(namespace.Set("other", test.value("value", 1)))
+
+		values, err := namespace.GetMany([]string{"stats", "other"})
+		test.Require().NoError(err)
+		test.Len(values, 2)
+		test.InDelta(1, values["other"].Fields["value"].GetNumberValue(), 0)
+
+		_, err = namespace.GetMany([]string{"stats", "unknown"})
+		test.ErrorIs(err, worker.ErrNamespaceNotFound)
+	})
+	test.Run("unknown namespace", func() {
+		_, err := namespace.Increment("unknown", test.value("count", 1))
+		test.ErrorIs(err, worker.ErrNamespaceNotFound)
+		test.ErrorIs(namespace.Delete("unknown", nil), worker.ErrNamespaceNotFound)
+	})
+}
+
+func (test *ContainerTest) TestConcurrentOperations() {
+	namespace := worker.CreateNamespace([]string{"stats"}, nil)
+
+	var wg sync.WaitGroup
+
+	for range 50 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := namespace.Increment("stats", test.value("count", 1))
+			test.NoError(err)
+			_, err = namespace.Append("stats", test.value("seen", true))
+			test.NoError(err)
+			namespace.Flush()
+			namespace.Get("stats")
+		}()
+	}
+
+	wg.Wait()
+
+	// Execution scoped namespaces are not flushed, so no write may be lost
+	namespace = worker.CreateNamespace([]string{"stats"}, map[string]pb.NamespaceScope{"stats": pb.NamespaceScope_EXECUTION})
+
+	for range 50 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := namespace.Increment("stats", test.value("count", 1))
+			test.NoError(err)
+			namespace.Flush()
+		}()
+	}
+
+	wg.Wait()
+	test.InDelta(50, namespace.Get("stats").Fields["count"].GetNumberValue(), 0)
+}
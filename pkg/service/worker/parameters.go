@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	worker_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
+)
+
+var ErrInvalidParameter = errors.New("invalid parameter")
+
+func validateParameterValue(valueType, value string) error {
+	var err error
+
+	switch valueType {
+	case "int":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "float":
+		_, err = strconv.ParseFloat(value, 64)
+	case "bool":
+		_, err = strconv.ParseBool(value)
+	case "string":
+	default:
+		return fmt.Errorf("unsupported value type %s", valueType)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s is not a valid %s", value, valueType)
+	}
+
+	return nil
+}
+
+// ResolveParameters merges the values given for an execution with the defaults of the
+// algorithm, every parameter must end up with a value matching its value type.
+func ResolveParameters(algo *worker_pb.Algorithm) ([]*worker_pb.ExecutionConfiguration_Function, error) {
+	functions := make([]*worker_pb.ExecutionConfiguration_Function, 0, len(algo.Functions))
+
+	for _, function := range algo.Functions {
+		parameters := make([]*worker_pb.ExecutionConfiguration_FunctionParameter, 0, len(function.Parameters))
+
+		for _, parameter := range function.Parameters {
+			var value string
+
+			switch {
+			case parameter.Value != nil:
+				value = parameter.GetValue()
+			case parameter.DefaultValue != nil:
+				value = parameter.GetDefaultValue()
+			default:
+				return nil, fmt.Errorf("%w: %s of function %s has no value", ErrInvalidParameter, parameter.Key, function.Name)
+			}
+
+			if err := validateParameterValue(parameter.ValueType, value); err != nil {
+				return nil, fmt.Errorf("%w: %s of function %s: %w", ErrInvalidParameter, parameter.Key, function.Name, err)
+			}
+
+			parameters = append(parameters, &worker_pb.ExecutionConfiguration_FunctionParameter{
+				Key:   parameter.Key,
+				Value: value,
+			})
+		}
+
+		functions = append(functions, &worker_pb.ExecutionConfiguration_Function{
+			Name:       function.Name,
+			Parameters: parameters,
+		})
+	}
+
+	return functions, nil
+}
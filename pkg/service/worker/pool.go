@@ -4,35 +4,166 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/lhjnilsson/foreverbull/internal/environment"
+	"github.com/google/uuid"
+	"github.com/lhjnilsson/foreverbull/internal/ports"
 	"github.com/lhjnilsson/foreverbull/internal/socket"
 	finance_pb "github.com/lhjnilsson/foreverbull/pkg/pb/finance"
 	worker_pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	HeartbeatTask = "heartbeat"
+
+	DefaultFunctionTimeout   = time.Minute
+	DefaultHeartbeatInterval = 5 * time.Second
+	DefaultHeartbeatTimeout  = 2 * time.Second
+	// heartbeatMaxMissed is how many heartbeat rounds in a row a worker may miss.
+	heartbeatMaxMissed = 3
+
+	// PoolServerName is the name workers verify the pool certificate against.
+	PoolServerName      = "foreverbull-pool"
+	CredentialsValidity = 7 * 24 * time.Hour
+)
+
+var (
+	ErrWorkerTimeout = errors.New("worker did not respond in time")
+	ErrNoWorkers     = errors.New("no workers connected")
+	ErrInvalidOrder  = errors.New("invalid order")
+)
+
+// WorkerError is reported by a worker that failed to process a function, Symbol is only
+// set for functions executed in parallel.
+type WorkerError struct {
+	Function string
+	Symbol   string
+	Message  string
+}
+
+func (e *WorkerError) Error() string {
+	if e.Symbol == "" {
+		return fmt.Sprintf("worker error in %s: %s", e.Function, e.Message)
+	}
+
+	return fmt.Sprintf("worker error in %s for %s: %s", e.Function, e.Symbol, e.Message)
+}
+
+// OrderPolicy decides what happens to orders that are not valid for the request, such
+// as orders for symbols outside the universe or without an amount.
+type OrderPolicy int
+
+const (
+	// RejectInvalidOrders drops invalid orders and keeps processing.
+	RejectInvalidOrders OrderPolicy = iota
+	// FailOnInvalidOrders fails the pool on the first invalid order.
+	FailOnInvalidOrders
 )
 
 type Pool interface {
 	Configure() *worker_pb.ExecutionConfiguration
 	Process(ctx context.Context,
 		timestamp time.Time, symbols []string, portfolio *finance_pb.Portfolio) ([]*finance_pb.Order, error)
+	// Err returns the error that made the pool fail, a failed pool does not process
+	// any more periods.
+	Err() error
+	Namespace() Namespace
 	Close() error
 }
 
-func NewPool(ctx context.Context, algo *worker_pb.Algorithm) (Pool, error) {
-	poolSocket, err := socket.NewRequester("0.0.0.0", 0, false)
-	if err != nil {
-		return nil, fmt.Errorf("error creating requester: %w", err)
+type PoolOption func(*pool)
+
+// SnapshotFunc stores the namespace state after a period has been processed.
+type SnapshotFunc func(ctx context.Context, timestamp time.Time, snapshot map[string]*structpb.Struct) error
+
+// WithFunctionTimeout sets how long a worker may take to process a single function.
+func WithFunctionTimeout(timeout time.Duration) PoolOption {
+	return func(p *pool) {
+		p.functionTimeout = timeout
 	}
+}
 
-	namespaceSocket, err := socket.NewReplier("0.0.0.0", 0, false)
-	if err != nil {
-		return nil, fmt.Errorf("error creating replier: %w", err)
+// WithHeartbeat sets how often idle workers are checked and how long they have to
+// answer, a worker fails the pool once it misses three checks in a row. An interval of
+// zero disables heartbeats.
+func WithHeartbeat(interval, timeout time.Duration) PoolOption {
+	return func(p *pool) {
+		p.heartbeatInterval = interval
+		p.heartbeatTimeout = timeout
+	}
+}
+
+// WithOrderPolicy sets how invalid orders from workers are handled.
+func WithOrderPolicy(policy OrderPolicy) PoolOption {
+	return func(p *pool) {
+		p.orderPolicy = policy
 	}
+}
+
+// WithNamespaceState restores namespace values when the pool is created, used to carry
+// state over from an earlier execution.
+func WithNamespaceState(values map[string]*structpb.Struct) PoolOption {
+	return func(p *pool) {
+		p.namespace.Restore(values)
+	}
+}
 
+// WithNamespaceSnapshots calls snapshot with the state of all namespaces after every
+// processed period.
+func WithNamespaceSnapshots(snapshot SnapshotFunc) PoolOption {
+	return func(p *pool) {
+		p.snapshot = snapshot
+	}
+}
+
+// WithPublisher broadcasts the orders of every processed period on publisher, the
+// publisher is owned by the caller and not closed with the pool.
+func WithPublisher(publisher socket.Publisher) PoolOption {
+	return func(p *pool) {
+		p.publisher = publisher
+	}
+}
+
+// WithBroker makes workers connect through broker instead of the pool sockets.
+func WithBroker(broker *Broker) PoolOption {
+	return func(p *pool) {
+		p.broker = broker
+	}
+}
+
+// WithTLS makes the pool sockets require TLS with certificates created for this pool
+// only, workers get the credentials from Configure. It has no effect with WithBroker.
+func WithTLS() PoolOption {
+	return func(p *pool) {
+		p.tls = true
+	}
+}
+
+// WithCredentials is WithTLS with credentials shared with other sockets, such as the
+// broadcast publisher of a session.
+func WithCredentials(credentials *socket.Credentials) PoolOption {
+	return func(p *pool) {
+		p.tls = true
+		p.credentials = credentials
+	}
+}
+
+// WithPortOwner sets the owner of the ports leased for the pool sockets, the pool
+// itself by default.
+func WithPortOwner(owner ports.Owner) PoolOption {
+	return func(p *pool) {
+		p.portOwner = owner
+	}
+}
+
+func NewPool(ctx context.Context, algo *worker_pb.Algorithm, options ...PoolOption) (Pool, error) {
 	if algo == nil {
 		return nil, errors.New("algorithm is not set")
 	}
@@ -41,20 +172,341 @@ func NewPool(ctx context.Context, algo *worker_pb.Algorithm) (Pool, error) {
 		log.Warn().Any("algorithm", algo).Msg("no functions in algorithm")
 	}
 
-	namespace := CreateNamespace(algo.Namespaces)
+	dependencies, err := functionDependencies(algo.Functions)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving function dependencies: %w", err)
+	}
+
+	functions, err := ResolveParameters(algo)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving parameters: %w", err)
+	}
+
+	namespace := CreateNamespace(algo.Namespaces, algo.NamespaceScopes)
+
+	p := &pool{
+		id:                uuid.New().String(),
+		algo:              algo,
+		namespace:         namespace,
+		dependencies:      dependencies,
+		functions:         functions,
+		functionTimeout:   DefaultFunctionTimeout,
+		heartbeatInterval: DefaultHeartbeatInterval,
+		heartbeatTimeout:  DefaultHeartbeatTimeout,
+		failed:            make(chan struct{}),
+		closed:            make(chan struct{}),
+	}
+	for _, option := range options {
+		option(p)
+	}
+
+	if p.broker != nil {
+		p.token, err = newPoolToken()
+		if err != nil {
+			return nil, fmt.Errorf("error creating pool token: %w", err)
+		}
+
+		p.Socket = newStreamRequester()
+		p.broker.register(p)
+
+		return p, nil
+	}
+
+	if p.portOwner == (ports.Owner{}) {
+		p.portOwner = ports.Owner{Kind: ports.Pool, ID: p.id}
+	}
+
+	socketOptions := []func(socket.OptionSetter) error{socket.WithOwner(p.portOwner)}
+
+	if p.tls && p.credentials == nil {
+		p.credentials, err = socket.NewCredentials(PoolServerName, CredentialsValidity)
+		if err != nil {
+			return nil, fmt.Errorf("error creating credentials: %w", err)
+		}
+	}
+
+	if p.tls {
+		serverTLS, err := p.credentials.ServerTLS()
+		if err != nil {
+			return nil, fmt.Errorf("error creating tls config: %w", err)
+		}
+
+		socketOptions = append(socketOptions, socket.WithTLS(serverTLS))
+	}
+
+	p.Socket, err = socket.NewRequester("0.0.0.0", 0, false, socketOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating requester: %w", err)
+	}
+
+	p.NamespaceSocket, err = socket.NewReplier("0.0.0.0", 0, false, socketOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating replier: %w", err)
+	}
 
-	p := &pool{Socket: poolSocket, NamespaceSocket: namespaceSocket, algo: algo, namespace: namespace}
 	go p.startNamespaceListener()
 
 	return p, nil
 }
 
 type pool struct {
+	id string
+
 	Socket          socket.Requester
 	NamespaceSocket socket.Replier
 
-	algo      *worker_pb.Algorithm
-	namespace Namespace
+	algo         *worker_pb.Algorithm
+	namespace    Namespace
+	dependencies map[string][]string
+	functions    []*worker_pb.ExecutionConfiguration_Function
+
+	// connected is set once a worker has connected, losing all workers after that fails the pool
+	connected atomic.Bool
+
+	functionTimeout   time.Duration
+	heartbeatInterval time.Duration
+	heartbeatTimeout  time.Duration
+	heartbeatOnce     sync.Once
+	orderPolicy       OrderPolicy
+	snapshot          SnapshotFunc
+	publisher         socket.Publisher
+	broker            *Broker
+	portOwner         ports.Owner
+	tls               bool
+	credentials       *socket.Credentials
+	// token authenticates workers connecting through the broker
+	token string
+
+	// requestLock is held while processing, heartbeats are only sent when it is free
+	requestLock sync.Mutex
+
+	err       atomic.Value
+	failed    chan struct{}
+	failOnce  sync.Once
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (p *pool) fail(err error) {
+	p.failOnce.Do(func() {
+		log.Error().Err(err).Msg("worker pool failed")
+		p.err.Store(err)
+		close(p.failed)
+	})
+}
+
+func (p *pool) Namespace() Namespace {
+	return p.namespace
+}
+
+func (p *pool) Err() error {
+	if err, isErr := p.err.Load().(error); isErr {
+		return err
+	}
+
+	return nil
+}
+
+// request sends the request to a worker and waits for the response. A worker that
+// does not respond within the time budget, or all workers disconnecting, fails the pool.
+func (p *pool) request(ctx context.Context, request *worker_pb.WorkerRequest, timeout time.Duration) (*worker_pb.WorkerResponse, error) {
+	response := &worker_pb.WorkerResponse{}
+	result := make(chan error, 1)
+
+	options := []func(socket.OptionSetter) error{socket.WithSendTimeout(timeout), socket.WithReadTimeout(timeout)}
+	if p.connected.Load() || p.Socket.Peers() > 0 {
+		p.connected.Store(true)
+
+		options = append(options, socket.WithFailNoPeers())
+	}
+
+	go func() {
+		result <- p.Socket.Request(request, response, options...)
+	}()
+
+	select {
+	case err := <-result:
+		switch {
+		case err == nil:
+			return response, nil
+		case errors.Is(err, socket.ErrReadTimeout), errors.Is(err, socket.ErrSendTimeout):
+			p.fail(fmt.Errorf("%w: %s did not respond within %s", ErrWorkerTimeout, request.Task, timeout))
+		case errors.Is(err, socket.ErrNoPeers):
+			p.fail(fmt.Errorf("%w: lost connection while processing %s", ErrNoWorkers, request.Task))
+		default:
+			return nil, err
+		}
+
+		return nil, p.Err()
+	case <-p.failed:
+		return nil, p.Err()
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error waiting for worker: %w", ctx.Err())
+	}
+}
+
+// orders returns the orders of a response, a worker error or an invalid order under
+// FailOnInvalidOrders fails the pool.
+func (p *pool) orders(request *worker_pb.WorkerRequest, response *worker_pb.WorkerResponse, symbol string) ([]*finance_pb.Order, error) {
+	if response.Error != nil {
+		p.fail(&WorkerError{Function: request.Task, Symbol: symbol, Message: response.GetError()})
+		return nil, p.Err()
+	}
+
+	orders := make([]*finance_pb.Order, 0, len(response.Orders))
+
+	for _, order := range response.Orders {
+		var err error
+
+		switch {
+		case !slices.Contains(request.Symbols, order.Symbol):
+			err = fmt.Errorf("%w: %s returned order for %s outside of requested symbols", ErrInvalidOrder, request.Task, order.Symbol)
+		case order.Amount == 0:
+			err = fmt.Errorf("%w: %s returned order for %s without amount", ErrInvalidOrder, request.Task, order.Symbol)
+		default:
+			orders = append(orders, order)
+			continue
+		}
+
+		if p.orderPolicy == FailOnInvalidOrders {
+			p.fail(err)
+			return nil, p.Err()
+		}
+
+		log.Warn().Err(err).Msg("rejecting order")
+	}
+
+	return orders, nil
+}
+
+// heartbeat checks that workers are still alive between periods, a dead worker is
+// otherwise only noticed once the next period is processed.
+func (p *pool) heartbeat() {
+	ticker := time.NewTicker(p.heartbeatInterval)
+	defer ticker.Stop()
+
+	missed := make(map[uint32]int)
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.failed:
+			return
+		case <-p.closed:
+			return
+		}
+
+		if !p.requestLock.TryLock() {
+			continue
+		}
+
+		err := p.heartbeatRound(missed)
+		p.requestLock.Unlock()
+
+		if err == nil {
+			continue
+		}
+
+		select {
+		case <-p.closed:
+		default:
+			p.fail(err)
+		}
+
+		return
+	}
+}
+
+// heartbeatRound checks that every connected worker replies within the heartbeat timeout.
+// Requests are load balanced over the workers and can not be addressed to one of them, so
+// heartbeats are sent for as long as a worker has not replied, up to heartbeatMaxMissed
+// requests per worker. Each worker is judged by its own replies and the round fails once a
+// single worker has missed heartbeatMaxMissed rounds in a row.
+func (p *pool) heartbeatRound(missed map[uint32]int) error {
+	peers := p.Socket.Peers()
+	if peers == 0 {
+		if p.connected.Load() {
+			return fmt.Errorf("%w: lost connection while idle", ErrNoWorkers)
+		}
+
+		return nil
+	}
+
+	p.connected.Store(true)
+
+	started := time.Now()
+	deadline := started.Add(p.heartbeatTimeout)
+
+	unanswered := func() bool {
+		for _, replied := range p.Socket.Replies() {
+			if replied.Before(started) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	done := make(chan error, peers)
+	inFlight, sent := 0, 0
+	failed := false
+
+	for {
+		for inFlight < peers && sent < peers*heartbeatMaxMissed && !failed && time.Now().Before(deadline) &&
+			unanswered() {
+			inFlight++
+			sent++
+
+			go func() {
+				timeout := time.Until(deadline)
+				done <- p.Socket.Request(&worker_pb.WorkerRequest{Task: HeartbeatTask}, &worker_pb.WorkerResponse{},
+					socket.WithSendTimeout(timeout), socket.WithReadTimeout(timeout))
+			}()
+		}
+
+		if inFlight == 0 {
+			break
+		}
+
+		err := <-done
+		inFlight--
+
+		if err != nil && !errors.Is(err, socket.ErrReadTimeout) && !errors.Is(err, socket.ErrSendTimeout) {
+			// Stop sending, the error is not about a single worker
+			failed = true
+
+			if !errors.Is(err, socket.ErrClosed) {
+				log.Warn().Err(err).Msg("error sending heartbeat to worker")
+			}
+		}
+	}
+
+	replies := p.Socket.Replies()
+
+	for id := range missed {
+		if _, connected := replies[id]; !connected {
+			delete(missed, id)
+		}
+	}
+
+	for id, replied := range replies {
+		if !replied.Before(started) {
+			missed[id] = 0
+			continue
+		}
+
+		// A round cut short by an error says nothing about the worker
+		if failed {
+			continue
+		}
+
+		missed[id]++
+		if missed[id] >= heartbeatMaxMissed {
+			return fmt.Errorf("%w: worker %d missed %d heartbeats", ErrWorkerTimeout, id, missed[id])
+		}
+	}
+
+	return nil
 }
 
 func (p *pool) startNamespaceListener() {
@@ -74,17 +526,7 @@ func (p *pool) startNamespaceListener() {
 			continue
 		}
 
-		switch request.Type {
-		case worker_pb.NamespaceRequestType_GET:
-			value := p.namespace.Get(request.Key)
-			response.Value = value
-		case worker_pb.NamespaceRequestType_SET:
-			err := p.namespace.Set(request.Key, request.Value)
-			if err != nil {
-				setError := err.Error()
-				response.Error = &setError
-			}
-		}
+		p.namespaceRequest(&request, &response)
 
 		err = sock.Reply(&response)
 		if err != nil {
@@ -93,49 +535,134 @@ func (p *pool) startNamespaceListener() {
 	}
 }
 
-func (p *pool) orderedFunctions() (<-chan *worker_pb.Algorithm_Function, error) {
-	if p.algo == nil {
-		return nil, errors.New("algorithm not set")
+func (p *pool) namespaceRequest(request *worker_pb.NamespaceRequest, response *worker_pb.NamespaceResponse) {
+	var err error
+
+	switch request.Type {
+	case worker_pb.NamespaceRequestType_GET:
+		response.Value = p.namespace.Get(request.Key)
+	case worker_pb.NamespaceRequestType_SET:
+		err = p.namespace.Set(request.Key, request.Value)
+	case worker_pb.NamespaceRequestType_INCREMENT:
+		response.Value, err = p.namespace.Increment(request.Key, request.Value)
+	case worker_pb.NamespaceRequestType_APPEND:
+		response.Value, err = p.namespace.Append(request.Key, request.Value)
+	case worker_pb.NamespaceRequestType_DELETE:
+		err = p.namespace.Delete(request.Key, request.Fields)
+	case worker_pb.NamespaceRequestType_COMPARE_AND_SET:
+		response.Swapped, err = p.namespace.CompareAndSet(request.Key, request.Expected, request.Value)
+	case worker_pb.NamespaceRequestType_GET_MANY:
+		response.Values, err = p.namespace.GetMany(request.Keys)
+	default:
+		err = fmt.Errorf("%w: unknown request type %s", ErrInvalidOperation, request.Type)
 	}
-	if len(p.algo.Functions) == 0 {
-		return nil, errors.New("no functions in algorithm")
+
+	if err != nil {
+		errMsg := err.Error()
+		response.Error = &errMsg
 	}
+}
 
-	functionCh := make(chan *worker_pb.Algorithm_Function)
-	go func() {
-		for _, function := range p.algo.Functions {
-			if function.RunFirst && !function.RunLast {
-				functionCh <- function
+// functionDependencies returns the functions each function waits for within a period.
+// Besides the declared dependencies, functions wait for all RunFirst functions and
+// RunLast functions wait for all other functions.
+func functionDependencies(functions []*worker_pb.Algorithm_Function) (map[string][]string, error) {
+	dependencies := make(map[string][]string, len(functions))
+
+	for _, function := range functions {
+		if _, exists := dependencies[function.Name]; exists {
+			return nil, fmt.Errorf("function %s is defined more than once", function.Name)
+		}
+
+		dependencies[function.Name] = []string{}
+	}
+
+	for _, function := range functions {
+		for _, dependency := range function.DependsOn {
+			if _, exists := dependencies[dependency]; !exists {
+				return nil, fmt.Errorf("function %s depends on unknown function %s", function.Name, dependency)
 			}
+
+			dependencies[function.Name] = append(dependencies[function.Name], dependency)
 		}
 
-		for _, function := range p.algo.Functions {
-			if !function.RunFirst && !function.RunLast {
-				functionCh <- function
+		for _, other := range functions {
+			if other.Name == function.Name || slices.Contains(dependencies[function.Name], other.Name) {
+				continue
+			}
+
+			runsBefore := other.RunFirst && !function.RunFirst || function.RunLast && !other.RunLast
+			if runsBefore {
+				dependencies[function.Name] = append(dependencies[function.Name], other.Name)
 			}
 		}
+	}
 
-		for _, function := range p.algo.Functions {
-			if !function.RunFirst && function.RunLast {
-				functionCh <- function
+	// Depth first search for cycles
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	state := make(map[string]int, len(functions))
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("dependency cycle detected at function %s", name)
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency); err != nil {
+				return err
 			}
 		}
 
-		close(functionCh)
-	}()
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, function := range functions {
+		if err := visit(function.Name); err != nil {
+			return nil, err
+		}
+	}
 
-	return functionCh, nil
+	return dependencies, nil
 }
 
 func (p *pool) Configure() *worker_pb.ExecutionConfiguration {
-	functions := make([]*worker_pb.ExecutionConfiguration_Function, 0)
+	configuration := &worker_pb.ExecutionConfiguration{
+		Functions: p.functions,
+	}
+	if p.broker != nil {
+		configuration.Transport = worker_pb.WorkerTransport_GRPC
+		configuration.Pool = p.id
+		configuration.PoolToken = p.token
+	} else {
+		configuration.BrokerPort = int32(p.Socket.GetPort())
+		configuration.NamespacePort = int32(p.NamespaceSocket.GetPort())
+	}
 
-	return &worker_pb.ExecutionConfiguration{
-		BrokerPort:    int32(p.Socket.GetPort()),
-		NamespacePort: int32(p.NamespaceSocket.GetPort()),
-		DatabaseURL:   environment.GetPostgresURL(),
-		Functions:     functions,
+	if p.credentials != nil {
+		configuration.Credentials = &worker_pb.ExecutionConfiguration_Credentials{
+			CaCertificate: p.credentials.CA,
+			Certificate:   p.credentials.ClientCert,
+			PrivateKey:    p.credentials.ClientKey,
+			ServerName:    p.credentials.ServerName,
+		}
+	}
+	if p.publisher != nil {
+		configuration.BroadcastPort = int32(p.publisher.GetPort())
 	}
+
+	return configuration
 }
 
 func (p *pool) Process(ctx context.Context, timestamp time.Time, symbols []string,
@@ -145,69 +672,154 @@ func (p *pool) Process(ctx context.Context, timestamp time.Time, symbols []strin
 		return nil, errors.New("algorithm not set")
 	}
 
-	p.namespace.Flush()
-
-	var orders []*finance_pb.Order
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
 
-	functions, err := p.orderedFunctions()
-	if err != nil {
-		return nil, fmt.Errorf("error getting ordered functions: %w", err)
+	// Workers are connected once the first period is processed
+	if p.heartbeatInterval > 0 {
+		p.heartbeatOnce.Do(func() {
+			go p.heartbeat()
+		})
 	}
-	for function := range functions {
-		if function.ParallelExecution {
-			group, _ := errgroup.WithContext(ctx)
-			orderWriteMutex := sync.Mutex{}
 
-			for _, symbol := range symbols {
-				s := symbol
+	p.requestLock.Lock()
+	defer p.requestLock.Unlock()
 
-				group.Go(func() error {
-					request := worker_pb.WorkerRequest{
-						Task:      function.Name,
-						Symbols:   []string{s},
-						Portfolio: portfolio,
-					}
-					response := worker_pb.WorkerResponse{}
+	p.namespace.Flush()
 
-					err := p.Socket.Request(&request, &response)
-					if err != nil {
-						return fmt.Errorf("error processing request: %w", err)
-					}
+	if len(p.algo.Functions) == 0 {
+		return nil, errors.New("no functions in algorithm")
+	}
 
-					orderWriteMutex.Lock()
-					defer orderWriteMutex.Unlock()
+	// Every function starts as soon as the functions it depends on are done, so
+	// independent functions run at the same time.
+	done := make(map[string]chan struct{}, len(p.algo.Functions))
+	for _, function := range p.algo.Functions {
+		done[function.Name] = make(chan struct{})
+	}
 
-					orders = append(orders, response.Orders...)
+	var orders []*finance_pb.Order
 
-					return nil
-				})
+	orderWriteMutex := sync.Mutex{}
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	for _, function := range p.algo.Functions {
+		group.Go(func() error {
+			for _, dependency := range p.dependencies[function.Name] {
+				select {
+				case <-done[dependency]:
+				case <-groupCtx.Done():
+					return fmt.Errorf("error waiting for %s: %w", dependency, groupCtx.Err())
+				}
 			}
 
-			err := group.Wait()
+			functionOrders, err := p.processFunction(groupCtx, function, symbols, portfolio)
 			if err != nil {
-				return nil, fmt.Errorf("error processing request: %w", err)
+				return err
 			}
-		} else {
+
+			orderWriteMutex.Lock()
+			orders = append(orders, functionOrders...)
+			orderWriteMutex.Unlock()
+
+			close(done[function.Name])
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, fmt.Errorf("error processing request: %w", err)
+	}
+
+	if p.snapshot != nil {
+		if err := p.snapshot(ctx, timestamp, p.namespace.Snapshot()); err != nil {
+			return nil, fmt.Errorf("error storing namespace snapshot: %w", err)
+		}
+	}
+
+	if p.publisher != nil {
+		err := p.publisher.Publish(&worker_pb.Broadcast{
+			Timestamp: timestamppb.New(timestamp),
+			Payload:   &worker_pb.Broadcast_Orders_{Orders: &worker_pb.Broadcast_Orders{Orders: orders}},
+		})
+		if err != nil {
+			log.Warn().Err(err).Msg("error broadcasting orders")
+		}
+	}
+
+	return orders, nil
+}
+
+// processFunction runs a single function, parallel functions are sent as one request
+// per symbol.
+func (p *pool) processFunction(ctx context.Context, function *worker_pb.Algorithm_Function, symbols []string,
+	portfolio *finance_pb.Portfolio,
+) ([]*finance_pb.Order, error) {
+	if !function.ParallelExecution {
+		request := worker_pb.WorkerRequest{
+			Task:      function.Name,
+			Symbols:   symbols,
+			Portfolio: portfolio,
+		}
+
+		response, err := p.request(ctx, &request, p.functionTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("error processing request: %w", err)
+		}
+
+		return p.orders(&request, response, "")
+	}
+
+	var orders []*finance_pb.Order
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	orderWriteMutex := sync.Mutex{}
+
+	for _, symbol := range symbols {
+		group.Go(func() error {
 			request := worker_pb.WorkerRequest{
 				Task:      function.Name,
-				Symbols:   symbols,
+				Symbols:   []string{symbol},
 				Portfolio: portfolio,
 			}
-			response := worker_pb.WorkerResponse{}
 
-			err := p.Socket.Request(&request, &response)
+			response, err := p.request(groupCtx, &request, p.functionTimeout)
 			if err != nil {
-				return nil, fmt.Errorf("error processing request: %w", err)
+				return fmt.Errorf("error processing request: %w", err)
 			}
 
-			orders = append(orders, response.Orders...)
-		}
+			validOrders, err := p.orders(&request, response, symbol)
+			if err != nil {
+				return err
+			}
+
+			orderWriteMutex.Lock()
+			defer orderWriteMutex.Unlock()
+
+			orders = append(orders, validOrders...)
+
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
 	}
 
 	return orders, nil
 }
 
 func (p *pool) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.closed)
+	})
+
+	if p.broker != nil {
+		p.broker.deregister(p.id)
+	}
+
 	if p.Socket != nil {
 		err := p.Socket.Close()
 		if err != nil && !errors.Is(err, socket.ErrClosed) {
@@ -2,19 +2,26 @@ package worker_test
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/lhjnilsson/foreverbull/internal/socket"
 	"github.com/lhjnilsson/foreverbull/internal/test_helper"
 	finance_pb "github.com/lhjnilsson/foreverbull/pkg/pb/finance"
 	pb "github.com/lhjnilsson/foreverbull/pkg/pb/service"
 	"github.com/lhjnilsson/foreverbull/pkg/service/worker"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.nanomsg.org/mangos/v3"
 	"go.nanomsg.org/mangos/v3/protocol/rep"
 	"go.nanomsg.org/mangos/v3/protocol/req"
 	_ "go.nanomsg.org/mangos/v3/transport/all" // for mangos transport.
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type PoolTest struct {
@@ -65,3 +72,700 @@ func (test *PoolTest) TestSimple() {
 	socket.Close()
 	namespaceSocket.Close()
 }
+
+func (test *PoolTest) connectWorker(pool worker.Pool) mangos.Socket {
+	socket, err := rep.NewSocket()
+	test.Require().NoError(err)
+	test.Require().NoError(socket.Dial(fmt.Sprintf("tcp://localhost:%d", pool.Configure().BrokerPort)))
+	test.Require().NoError(socket.SetOption(mangos.OptionRecvDeadline, time.Second))
+	test.Require().NoError(socket.SetOption(mangos.OptionSendDeadline, time.Second))
+
+	return socket
+}
+
+func (test *PoolTest) reply(socket mangos.Socket) string {
+	msg, err := socket.Recv()
+	test.Require().NoError(err)
+
+	request := pb.WorkerRequest{}
+	test.Require().NoError(proto.Unmarshal(msg, &request))
+
+	data, err := proto.Marshal(&pb.WorkerResponse{Task: request.Task})
+	test.Require().NoError(err)
+	test.Require().NoError(socket.Send(data))
+
+	return request.Task
+}
+
+func (test *PoolTest) TestFunctionTimeout() {
+	algo, _ := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{CB: test_helper.Example, Name: "slow"})
+	pool, err := worker.NewPool(context.TODO(), algo, worker.WithFunctionTimeout(time.Second/10))
+	test.Require().NoError(err)
+
+	defer pool.Close()
+
+	socket := test.connectWorker(pool)
+	defer socket.Close()
+
+	go func() {
+		_, err := socket.Recv()
+		test.NoError(err)
+	}()
+
+	_, err = pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+	test.ErrorIs(err, worker.ErrWorkerTimeout)
+	test.ErrorIs(pool.Err(), worker.ErrWorkerTimeout)
+
+	_, err = pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+	test.ErrorIs(err, worker.ErrWorkerTimeout)
+}
+
+func (test *PoolTest) TestWorkerDisconnect() {
+	algo, _ := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{CB: test_helper.Example, Name: "test"})
+	pool, err := worker.NewPool(context.TODO(), algo)
+	test.Require().NoError(err)
+
+	defer pool.Close()
+
+	socket := test.connectWorker(pool)
+
+	go func() {
+		test.Equal("test", test.reply(socket))
+		_, err := socket.Recv()
+		test.NoError(err)
+		socket.Close()
+	}()
+
+	_, err = pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+	test.Require().NoError(err)
+
+	started := time.Now()
+	_, err = pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+	test.ErrorIs(err, worker.ErrNoWorkers)
+	test.ErrorIs(pool.Err(), worker.ErrNoWorkers)
+	test.Less(time.Since(started), worker.DefaultFunctionTimeout)
+}
+
+func (test *PoolTest) TestHeartbeat() {
+	test.Run("healthy", func() {
+		algo, runner := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{CB: test_helper.Example, Name: "test"})
+		pool, err := worker.NewPool(context.TODO(), algo, worker.WithHeartbeat(time.Second/100, time.Second/10))
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		socket := test.connectWorker(pool)
+		defer socket.Close()
+
+		go runner(socket)
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+		test.Require().NoError(err)
+		time.Sleep(time.Second / 10)
+		test.NoError(pool.Err())
+	})
+	test.Run("unresponsive", func() {
+		algo, _ := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{CB: test_helper.Example, Name: "test"})
+		pool, err := worker.NewPool(context.TODO(), algo, worker.WithHeartbeat(time.Second/100, time.Second/10))
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		socket := test.connectWorker(pool)
+		defer socket.Close()
+
+		go func() {
+			test.Equal("test", test.reply(socket))
+			test.Equal(worker.HeartbeatTask, test.reply(socket))
+		}()
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+		test.Require().NoError(err)
+		test.Eventually(func() bool {
+			return errors.Is(pool.Err(), worker.ErrWorkerTimeout)
+		}, time.Second, time.Second/100)
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+		test.ErrorIs(err, worker.ErrWorkerTimeout)
+	})
+	test.Run("healthy workers", func() {
+		algo, runner := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{CB: test_helper.Example, Name: "test"})
+		pool, err := worker.NewPool(context.TODO(), algo, worker.WithHeartbeat(time.Second/100, time.Second/10))
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		for range 8 {
+			socket := test.connectWorker(pool)
+			defer socket.Close()
+
+			go runner(socket)
+		}
+
+		test.Eventually(func() bool {
+			_, err := pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+			return err == nil
+		}, time.Second, time.Second/100)
+		time.Sleep(time.Second / 2)
+		test.NoError(pool.Err())
+	})
+	test.Run("one unresponsive worker", func() {
+		algo, runner := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{CB: test_helper.Example, Name: "test"})
+		pool, err := worker.NewPool(context.TODO(), algo, worker.WithHeartbeat(time.Second/100, time.Second/10))
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		healthy := test.connectWorker(pool)
+		defer healthy.Close()
+
+		go runner(healthy)
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"test"}, &finance_pb.Portfolio{})
+		test.Require().NoError(err)
+
+		// Connected but never reads a request
+		unresponsive := test.connectWorker(pool)
+		defer unresponsive.Close()
+
+		test.Eventually(func() bool {
+			return errors.Is(pool.Err(), worker.ErrWorkerTimeout)
+		}, time.Second*2, time.Second/100)
+		test.ErrorContains(pool.Err(), "missed 3 heartbeats")
+	})
+}
+
+func (test *PoolTest) TestWorkerError() {
+	type testCase struct {
+		parallel bool
+		symbol   string
+		expected string
+	}
+
+	for _, tc := range []testCase{
+		{parallel: true, symbol: "AAPL", expected: "worker error in test for AAPL: ValueError()"},
+		{parallel: false, symbol: "", expected: "worker error in test: ValueError()"},
+	} {
+		test.Run(fmt.Sprintf("parallel=%t", tc.parallel), func() {
+			cb := func(_ *pb.WorkerRequest) *pb.WorkerResponse {
+				message := "ValueError()"
+				return &pb.WorkerResponse{Error: &message}
+			}
+			algo, runner := test_helper.WorkerSimulator(test.T(),
+				&test_helper.WorkerFunction{CB: cb, Name: "test", Parallel: tc.parallel})
+			pool, err := worker.NewPool(context.TODO(), algo)
+			test.Require().NoError(err)
+
+			defer pool.Close()
+
+			socket := test.connectWorker(pool)
+			defer socket.Close()
+
+			go runner(socket)
+
+			_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+			workerErr := &worker.WorkerError{}
+			test.Require().ErrorAs(err, &workerErr)
+			test.Equal("test", workerErr.Function)
+			test.Equal(tc.symbol, workerErr.Symbol)
+			test.EqualError(pool.Err(), tc.expected)
+		})
+	}
+}
+
+func (test *PoolTest) TestInvalidOrders() {
+	cb := func(_ *pb.WorkerRequest) *pb.WorkerResponse {
+		return &pb.WorkerResponse{Orders: []*finance_pb.Order{
+			{Symbol: "AAPL", Amount: 10},
+			{Symbol: "AAPL", Amount: 0},
+			{Symbol: "TSLA", Amount: 10},
+		}}
+	}
+
+	test.Run("reject", func() {
+		algo, runner := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{CB: cb, Name: "test"})
+		pool, err := worker.NewPool(context.TODO(), algo)
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		socket := test.connectWorker(pool)
+		defer socket.Close()
+
+		go runner(socket)
+
+		orders, err := pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+		test.Require().NoError(err)
+		test.Require().Len(orders, 1)
+		test.Equal("AAPL", orders[0].Symbol)
+		test.Equal(int32(10), orders[0].Amount)
+		test.NoError(pool.Err())
+	})
+	test.Run("fail", func() {
+		algo, runner := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{CB: cb, Name: "test"})
+		pool, err := worker.NewPool(context.TODO(), algo, worker.WithOrderPolicy(worker.FailOnInvalidOrders))
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		socket := test.connectWorker(pool)
+		defer socket.Close()
+
+		go runner(socket)
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+		test.ErrorIs(err, worker.ErrInvalidOrder)
+		test.ErrorIs(pool.Err(), worker.ErrInvalidOrder)
+	})
+}
+
+func (test *PoolTest) TestDependencyValidation() {
+	type testCase struct {
+		name      string
+		functions []*pb.Algorithm_Function
+		expected  string
+	}
+
+	for _, tc := range []testCase{
+		{
+			name: "unknown",
+			functions: []*pb.Algorithm_Function{
+				{Name: "allocate", DependsOn: []string{"rank"}},
+			},
+			expected: "function allocate depends on unknown function rank",
+		},
+		{
+			name: "duplicate",
+			functions: []*pb.Algorithm_Function{
+				{Name: "rank"},
+				{Name: "rank"},
+			},
+			expected: "function rank is defined more than once",
+		},
+		{
+			name: "cycle",
+			functions: []*pb.Algorithm_Function{
+				{Name: "rank", DependsOn: []string{"allocate"}},
+				{Name: "allocate", DependsOn: []string{"rank"}},
+			},
+			expected: "dependency cycle detected",
+		},
+		{
+			name: "cycle with run first",
+			functions: []*pb.Algorithm_Function{
+				{Name: "rank", RunFirst: true, DependsOn: []string{"allocate"}},
+				{Name: "allocate"},
+			},
+			expected: "dependency cycle detected",
+		},
+	} {
+		test.Run(tc.name, func() {
+			_, err := worker.NewPool(context.TODO(), &pb.Algorithm{Functions: tc.functions})
+			test.ErrorContains(err, tc.expected)
+		})
+	}
+}
+
+func (test *PoolTest) TestDependencies() {
+	var namespaceSocket mangos.Socket
+
+	namespace := func(request *pb.NamespaceRequest) *pb.NamespaceResponse {
+		data, err := proto.Marshal(request)
+		test.Require().NoError(err)
+		test.Require().NoError(namespaceSocket.Send(data))
+		data, err = namespaceSocket.Recv()
+		test.Require().NoError(err)
+
+		response := &pb.NamespaceResponse{}
+		test.Require().NoError(proto.Unmarshal(data, response))
+
+		return response
+	}
+
+	called := make([]string, 0)
+	rank := func(req *pb.WorkerRequest) *pb.WorkerResponse {
+		called = append(called, req.Task)
+		value, err := structpb.NewStruct(map[string]interface{}{"top": "AAPL"})
+		test.Require().NoError(err)
+		namespace(&pb.NamespaceRequest{Key: "ranking", Type: pb.NamespaceRequestType_SET, Value: value})
+
+		return &pb.WorkerResponse{}
+	}
+	allocate := func(req *pb.WorkerRequest) *pb.WorkerResponse {
+		called = append(called, req.Task)
+		response := namespace(&pb.NamespaceRequest{Key: "ranking", Type: pb.NamespaceRequestType_GET})
+
+		return &pb.WorkerResponse{Orders: []*finance_pb.Order{
+			{Symbol: response.Value.Fields["top"].GetStringValue(), Amount: 10},
+		}}
+	}
+	record := func(req *pb.WorkerRequest) *pb.WorkerResponse {
+		called = append(called, req.Task)
+		return &pb.WorkerResponse{}
+	}
+
+	algo, runner := test_helper.WorkerSimulator(test.T(),
+		&test_helper.WorkerFunction{CB: allocate, Name: "allocate"},
+		&test_helper.WorkerFunction{CB: rank, Name: "rank"},
+		&test_helper.WorkerFunction{CB: record, Name: "first", RunFirst: true},
+		&test_helper.WorkerFunction{CB: record, Name: "last", RunLast: true},
+	)
+	algo.Functions[0].DependsOn = []string{"rank"}
+	algo.Namespaces = []string{"ranking"}
+
+	pool, err := worker.NewPool(context.TODO(), algo)
+	test.Require().NoError(err)
+
+	defer pool.Close()
+
+	socket := test.connectWorker(pool)
+	defer socket.Close()
+
+	namespaceSocket, err = req.NewSocket()
+	test.Require().NoError(err)
+	test.Require().NoError(namespaceSocket.Dial(fmt.Sprintf("tcp://localhost:%d", pool.Configure().NamespacePort)))
+	test.Require().NoError(namespaceSocket.SetOption(mangos.OptionRecvDeadline, time.Second))
+
+	defer namespaceSocket.Close()
+
+	go runner(socket)
+
+	orders, err := pool.Process(context.TODO(), time.Now(), []string{"AAPL", "TSLA"}, &finance_pb.Portfolio{})
+	test.Require().NoError(err)
+	test.Require().Len(orders, 1)
+	test.Equal("AAPL", orders[0].Symbol)
+	test.Equal([]string{"first", "rank", "allocate", "last"}, called)
+}
+
+func (test *PoolTest) TestParameters() {
+	value := func(v string) *string { return &v }
+	algorithm := func(parameters ...*pb.Algorithm_FunctionParameter) *pb.Algorithm {
+		return &pb.Algorithm{Functions: []*pb.Algorithm_Function{{Name: "test", Parameters: parameters}}}
+	}
+
+	test.Run("resolved", func() {
+		pool, err := worker.NewPool(context.TODO(), algorithm(
+			&pb.Algorithm_FunctionParameter{Key: "low", DefaultValue: value("5"), ValueType: "int"},
+			&pb.Algorithm_FunctionParameter{Key: "high", DefaultValue: value("10"), Value: value("15"), ValueType: "int"},
+			&pb.Algorithm_FunctionParameter{Key: "ratio", Value: value("0.5"), ValueType: "float"},
+			&pb.Algorithm_FunctionParameter{Key: "short", DefaultValue: value("True"), ValueType: "bool"},
+			&pb.Algorithm_FunctionParameter{Key: "name", Value: value("test"), ValueType: "string"},
+		))
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		functions := pool.Configure().Functions
+		test.Require().Len(functions, 1)
+		test.Equal("test", functions[0].Name)
+
+		parameters := make(map[string]string)
+		for _, parameter := range functions[0].Parameters {
+			parameters[parameter.Key] = parameter.Value
+		}
+
+		test.Equal(map[string]string{"low": "5", "high": "15", "ratio": "0.5", "short": "True", "name": "test"}, parameters)
+	})
+
+	type testCase struct {
+		name      string
+		parameter *pb.Algorithm_FunctionParameter
+		expected  string
+	}
+
+	for _, tc := range []testCase{
+		{"missing value", &pb.Algorithm_FunctionParameter{Key: "low", ValueType: "int"}, "low of function test has no value"},
+		{"invalid int", &pb.Algorithm_FunctionParameter{Key: "low", Value: value("0.5"), ValueType: "int"}, "0.5 is not a valid int"},
+		{"invalid float", &pb.Algorithm_FunctionParameter{Key: "ratio", Value: value("a"), ValueType: "float"}, "a is not a valid float"},
+		{"invalid bool", &pb.Algorithm_FunctionParameter{Key: "short", DefaultValue: value("maybe"), ValueType: "bool"}, "maybe is not a valid bool"},
+		{"unsupported type", &pb.Algorithm_FunctionParameter{Key: "list", Value: value("[]"), ValueType: "list"}, "unsupported value type list"},
+	} {
+		test.Run(tc.name, func() {
+			_, err := worker.NewPool(context.TODO(), algorithm(tc.parameter))
+			test.ErrorIs(err, worker.ErrInvalidParameter)
+			test.ErrorContains(err, tc.expected)
+		})
+	}
+}
+
+func (test *PoolTest) TestNamespaceSnapshots() {
+	algo, runner := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{
+		CB:   func(_ *pb.WorkerRequest) *pb.WorkerResponse { return &pb.WorkerResponse{} },
+		Name: "test",
+	})
+	algo.Namespaces = []string{"counter", "daily"}
+	algo.NamespaceScopes = map[string]pb.NamespaceScope{"counter": pb.NamespaceScope_SESSION}
+
+	counter, err := structpb.NewStruct(map[string]interface{}{"value": 3})
+	test.Require().NoError(err)
+
+	test.Run("stored", func() {
+		snapshots := make(map[time.Time]map[string]*structpb.Struct)
+		pool, err := worker.NewPool(context.TODO(), algo,
+			worker.WithNamespaceState(map[string]*structpb.Struct{"counter": counter}),
+			worker.WithNamespaceSnapshots(func(_ context.Context, timestamp time.Time, snapshot map[string]*structpb.Struct) error {
+				snapshots[timestamp] = snapshot
+				return nil
+			}),
+		)
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		socket := test.connectWorker(pool)
+		defer socket.Close()
+
+		go runner(socket)
+
+		timestamp := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		_, err = pool.Process(context.TODO(), timestamp, []string{"AAPL"}, &finance_pb.Portfolio{})
+		test.Require().NoError(err)
+
+		test.Require().Contains(snapshots, timestamp)
+		test.Require().Len(snapshots[timestamp], 2)
+		test.InDelta(3, snapshots[timestamp]["counter"].Fields["value"].GetNumberValue(), 0)
+		test.Empty(snapshots[timestamp]["daily"].Fields)
+
+		session := pool.Namespace().Snapshot(pb.NamespaceScope_SESSION)
+		test.Len(session, 1)
+		test.Contains(session, "counter")
+	})
+	test.Run("error", func() {
+		pool, err := worker.NewPool(context.TODO(), algo,
+			worker.WithNamespaceSnapshots(func(_ context.Context, _ time.Time, _ map[string]*structpb.Struct) error {
+				return errors.New("storage unavailable")
+			}),
+		)
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		socket := test.connectWorker(pool)
+		defer socket.Close()
+
+		go runner(socket)
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+		test.ErrorContains(err, "error storing namespace snapshot: storage unavailable")
+	})
+}
+
+func (test *PoolTest) TestNamespaceOperations() {
+	algo, _ := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{
+		CB:   func(_ *pb.WorkerRequest) *pb.WorkerResponse { return &pb.WorkerResponse{} },
+		Name: "test",
+	})
+	algo.Namespaces = []string{"stats", "ranking"}
+
+	pool, err := worker.NewPool(context.TODO(), algo)
+	test.Require().NoError(err)
+
+	defer pool.Close()
+
+	socket, err := req.NewSocket()
+	test.Require().NoError(err)
+	test.Require().NoError(socket.Dial(fmt.Sprintf("tcp://localhost:%d", pool.Configure().NamespacePort)))
+	test.Require().NoError(socket.SetOption(mangos.OptionRecvDeadline, time.Second))
+
+	defer socket.Close()
+
+	namespace := func(request *pb.NamespaceRequest) *pb.NamespaceResponse {
+		data, err := proto.Marshal(request)
+		test.Require().NoError(err)
+		test.Require().NoError(socket.Send(data))
+		data, err = socket.Recv()
+		test.Require().NoError(err)
+
+		response := &pb.NamespaceResponse{}
+		test.Require().NoError(proto.Unmarshal(data, response))
+
+		return response
+	}
+	value := func(key string, v interface{}) *structpb.Struct {
+		s, err := structpb.NewStruct(map[string]interface{}{key: v})
+		test.Require().NoError(err)
+
+		return s
+	}
+
+	response := namespace(&pb.NamespaceRequest{Key: "stats", Type: pb.NamespaceRequestType_INCREMENT, Value: value("count", 2)})
+	test.Require().Nil(response.Error)
+	test.InDelta(2, response.Value.Fields["count"].GetNumberValue(), 0)
+
+	response = namespace(&pb.NamespaceRequest{Key: "ranking", Type: pb.NamespaceRequestType_APPEND, Value: value("top", "AAPL")})
+	test.Require().Nil(response.Error)
+	test.Equal([]interface{}{"AAPL"}, response.Value.Fields["top"].GetListValue().AsSlice())
+
+	response = namespace(&pb.NamespaceRequest{
+		Key: "stats", Type: pb.NamespaceRequestType_COMPARE_AND_SET,
+		Expected: value("count", 2), Value: value("count", 10),
+	})
+	test.Require().Nil(response.Error)
+	test.True(response.Swapped)
+
+	response = namespace(&pb.NamespaceRequest{Key: "ranking", Type: pb.NamespaceRequestType_DELETE, Fields: []string{"top"}})
+	test.Require().Nil(response.Error)
+
+	response = namespace(&pb.NamespaceRequest{Type: pb.NamespaceRequestType_GET_MANY, Keys: []string{"stats", "ranking"}})
+	test.Require().Nil(response.Error)
+	test.InDelta(10, response.Values["stats"].Fields["count"].GetNumberValue(), 0)
+	test.Empty(response.Values["ranking"].Fields)
+
+	response = namespace(&pb.NamespaceRequest{Key: "stats", Type: pb.NamespaceRequestType_INCREMENT, Value: value("count", "a")})
+	test.Require().NotNil(response.Error)
+	test.Contains(response.GetError(), "count is not a number")
+}
+
+func (test *PoolTest) TestBroadcast() {
+	algo, runner := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{
+		CB: func(_ *pb.WorkerRequest) *pb.WorkerResponse {
+			return &pb.WorkerResponse{Orders: []*finance_pb.Order{{Symbol: "AAPL", Amount: 10}}}
+		},
+		Name: "test",
+	})
+
+	timestamp := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	publisher := socket.NewMockPublisher(test.T())
+	publisher.On("GetPort").Return(1234)
+	publisher.On("Publish", mock.MatchedBy(func(msg *pb.Broadcast) bool {
+		return msg.Timestamp.AsTime().Equal(timestamp) && len(msg.GetOrders().GetOrders()) == 1 &&
+			msg.GetOrders().GetOrders()[0].Symbol == "AAPL"
+	})).Return(errors.New("no listeners")).Once()
+
+	pool, err := worker.NewPool(context.TODO(), algo, worker.WithPublisher(publisher))
+	test.Require().NoError(err)
+
+	defer pool.Close()
+
+	test.Equal(int32(1234), pool.Configure().BroadcastPort)
+
+	workerSocket := test.connectWorker(pool)
+	defer workerSocket.Close()
+
+	go runner(workerSocket)
+
+	// Failing to broadcast does not fail the period
+	orders, err := pool.Process(context.TODO(), timestamp, []string{"AAPL"}, &finance_pb.Portfolio{})
+	test.Require().NoError(err)
+	test.Len(orders, 1)
+}
+
+func (test *PoolTest) TestTLS() {
+	algo, runner := test_helper.WorkerSimulator(test.T(), &test_helper.WorkerFunction{
+		CB:   func(_ *pb.WorkerRequest) *pb.WorkerResponse { return &pb.WorkerResponse{} },
+		Name: "test",
+	})
+	algo.Namespaces = []string{"stats"}
+
+	clientTLS := func(credentials *pb.ExecutionConfiguration_Credentials) *tls.Config {
+		cert, err := tls.X509KeyPair(credentials.Certificate, credentials.PrivateKey)
+		test.Require().NoError(err)
+
+		roots := x509.NewCertPool()
+		test.Require().True(roots.AppendCertsFromPEM(credentials.CaCertificate))
+
+		return &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      roots,
+			ServerName:   credentials.ServerName,
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+
+	test.Run("with credentials", func() {
+		pool, err := worker.NewPool(context.TODO(), algo, worker.WithTLS())
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		configuration := pool.Configure()
+		test.Require().NotNil(configuration.Credentials)
+		test.Equal(worker.PoolServerName, configuration.Credentials.ServerName)
+
+		options := map[string]interface{}{mangos.OptionTLSConfig: clientTLS(configuration.Credentials)}
+
+		socket, err := rep.NewSocket()
+		test.Require().NoError(err)
+		test.Require().NoError(socket.DialOptions(fmt.Sprintf("tls+tcp://localhost:%d", configuration.BrokerPort), options))
+		test.Require().NoError(socket.SetOption(mangos.OptionRecvDeadline, time.Second))
+		test.Require().NoError(socket.SetOption(mangos.OptionSendDeadline, time.Second))
+
+		defer socket.Close()
+
+		namespaceSocket, err := req.NewSocket()
+		test.Require().NoError(err)
+		test.Require().NoError(namespaceSocket.DialOptions(
+			fmt.Sprintf("tls+tcp://localhost:%d", configuration.NamespacePort), options))
+		test.Require().NoError(namespaceSocket.SetOption(mangos.OptionRecvDeadline, time.Second))
+
+		defer namespaceSocket.Close()
+
+		go runner(socket)
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+		test.Require().NoError(err)
+
+		data, err := proto.Marshal(&pb.NamespaceRequest{Key: "stats", Type: pb.NamespaceRequestType_GET})
+		test.Require().NoError(err)
+		test.Require().NoError(namespaceSocket.Send(data))
+		_, err = namespaceSocket.Recv()
+		test.Require().NoError(err)
+	})
+	test.Run("without credentials", func() {
+		pool, err := worker.NewPool(context.TODO(), algo, worker.WithTLS(),
+			worker.WithFunctionTimeout(time.Millisecond*300))
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		// The handshake never completes, so the worker has to dial asynchronously
+		socket, err := rep.NewSocket()
+		test.Require().NoError(err)
+		test.Require().NoError(socket.SetOption(mangos.OptionDialAsynch, true))
+		test.Require().NoError(socket.Dial(fmt.Sprintf("tcp://localhost:%d", pool.Configure().BrokerPort)))
+
+		defer socket.Close()
+
+		go runner(socket)
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+		test.ErrorIs(err, worker.ErrWorkerTimeout)
+	})
+	test.Run("shared credentials", func() {
+		credentials, err := socket.NewCredentials(worker.PoolServerName, time.Hour)
+		test.Require().NoError(err)
+
+		pool, err := worker.NewPool(context.TODO(), algo, worker.WithCredentials(credentials))
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		configuration := pool.Configure()
+		test.Require().NotNil(configuration.Credentials)
+		test.Equal(credentials.CA, configuration.Credentials.CaCertificate)
+
+		options := map[string]interface{}{mangos.OptionTLSConfig: clientTLS(configuration.Credentials)}
+
+		socket, err := rep.NewSocket()
+		test.Require().NoError(err)
+		test.Require().NoError(socket.DialOptions(fmt.Sprintf("tls+tcp://localhost:%d", configuration.BrokerPort), options))
+		test.Require().NoError(socket.SetOption(mangos.OptionRecvDeadline, time.Second))
+		test.Require().NoError(socket.SetOption(mangos.OptionSendDeadline, time.Second))
+
+		defer socket.Close()
+
+		go runner(socket)
+
+		_, err = pool.Process(context.TODO(), time.Now(), []string{"AAPL"}, &finance_pb.Portfolio{})
+		test.Require().NoError(err)
+	})
+	test.Run("plain", func() {
+		pool, err := worker.NewPool(context.TODO(), algo)
+		test.Require().NoError(err)
+
+		defer pool.Close()
+
+		test.Nil(pool.Configure().Credentials)
+	})
+}